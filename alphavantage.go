@@ -1,8 +1,11 @@
 package alphavantage
 
 import (
-	"errors"
+	"context"
 	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -23,16 +26,38 @@ type Service struct {
 	BasePath  string // API endpoint base URL
 	UserAgent string // optional additional User-Agent fragment
 
+	// RateLimiter is waited on by every Do before sending the request, nil means unlimited
+	RateLimiter *RateLimiter
+	// RetryPolicy retries throttled and 5xx responses, nil means no retry
+	RetryPolicy *RetryPolicy
+	// Cache caches successful responses, nil means no cache
+	Cache Cache
+	// CacheTTL is the time to live of cached responses by function, e.g. "TIME_SERIES_INTRADAY",
+	// the responses of a function without TTL are not cached. nil means DefaultCacheTTL.
+	CacheTTL map[string]time.Duration
+	// Middlewares hook around every Do, see Use
+	Middlewares []*Middleware
+
 	TimeSeries              *TimeSeriesService
 	ForeignExchange         *ForeignExchangeService
 	DigitalCryptoCurrencies *DigitalCryptoCurrenciesService
 	TechnicalIndicators     *TechnicalIndicatorsService
 	SectorPerformances      *SectorPerformancesService
+	Fundamentals            *FundamentalsService
+	EconomicIndicators      *EconomicIndicatorsService
 }
 
-// GetClient get client which could append apikey
-func GetClient(key string) *http.Client {
-	transport := newTransport(key)
+// GetClient get client which could append apikey,
+// several keys are used in turn, see GetPoolClient.
+// Without a key, every request fails with ErrNoAPIKey.
+func GetClient(keys ...string) *http.Client {
+	return GetPoolClient(NewKeyPool(KeySelectionRoundRobin, keys...))
+}
+
+// GetPoolClient get client which could append apikey from pool.
+// If pool is nil or has no key, every request fails with ErrNoAPIKey.
+func GetPoolClient(pool *KeyPool) *http.Client {
+	transport := newPoolTransport(pool)
 
 	client := &http.Client{
 		Transport: transport,
@@ -52,10 +77,44 @@ func New(client *http.Client) (*Service, error) {
 	s.DigitalCryptoCurrencies = NewDigitalCryptoCurrenciesService(s)
 	s.TechnicalIndicators = NewTechnicalIndicatorsService(s)
 	s.SectorPerformances = NewSectorPerformancesService(s)
+	s.Fundamentals = NewFundamentalsService(s)
+	s.EconomicIndicators = NewEconomicIndicatorsService(s)
 
 	return s, nil
 }
 
+// sendRequest waits on the RateLimiter and sends req,
+// retrying by the RetryPolicy if there is one
+func (s *Service) sendRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
+	for retry := 0; ; retry++ {
+		if s.RateLimiter != nil {
+			if err := s.RateLimiter.Wait(ctx); err != nil {
+				return nil, errors.Wrapf(err, "RateLimiter.Wait")
+			}
+		}
+
+		// the transport may modify the request, e.g. add apikey
+		res, err := SendRequest(ctx, s.client, req.Clone(req.Context()))
+		if err != nil || s.RetryPolicy == nil || retry >= s.RetryPolicy.MaxRetries {
+			return res, err
+		}
+
+		ok, err := s.RetryPolicy.retryable(res)
+		if err != nil {
+			res.Body.Close()
+			return nil, errors.Wrapf(err, "RetryPolicy.retryable")
+		}
+		if !ok {
+			return res, nil
+		}
+		res.Body.Close()
+
+		if err := sleep(ctx, s.RetryPolicy.backoff(retry+1)); err != nil {
+			return nil, errors.Wrapf(err, "sleep")
+		}
+	}
+}
+
 func (s *Service) userAgent() string {
 	if s.UserAgent == "" {
 		return UserAgent
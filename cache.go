@@ -0,0 +1,276 @@
+package alphavantage
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Cache caches responses by the canonical request URL without apikey
+type Cache interface {
+	// Get returns the response cached for key, false if there is none or it is expired
+	Get(key string) ([]byte, bool)
+	// Set caches the response for key during ttl
+	Set(key string, response []byte, ttl time.Duration)
+}
+
+// DefaultCacheTTL returns the time to live of cached responses by function,
+// about how often the server updates each of them
+func DefaultCacheTTL() map[string]time.Duration {
+	return map[string]time.Duration{
+		"TIME_SERIES_INTRADAY":         time.Minute,
+		"TIME_SERIES_DAILY":            time.Hour,
+		"TIME_SERIES_DAILY_ADJUSTED":   time.Hour,
+		"TIME_SERIES_WEEKLY":           24 * time.Hour,
+		"TIME_SERIES_WEEKLY_ADJUSTED":  24 * time.Hour,
+		"TIME_SERIES_MONTHLY":          24 * time.Hour,
+		"TIME_SERIES_MONTHLY_ADJUSTED": 24 * time.Hour,
+		"SYMBOL_SEARCH":                24 * time.Hour,
+		"FX_INTRADAY":                  time.Minute,
+		"FX_DAILY":                     time.Hour,
+		"FX_WEEKLY":                    24 * time.Hour,
+		"FX_MONTHLY":                   24 * time.Hour,
+		"CRYPTO_INTRADAY":              time.Minute,
+		"DIGITAL_CURRENCY_DAILY":       time.Hour,
+		"DIGITAL_CURRENCY_WEEKLY":      24 * time.Hour,
+		"DIGITAL_CURRENCY_MONTHLY":     24 * time.Hour,
+		"OVERVIEW":                     24 * time.Hour,
+		"INCOME_STATEMENT":             24 * time.Hour,
+		"BALANCE_SHEET":                24 * time.Hour,
+		"CASH_FLOW":                    24 * time.Hour,
+		"EARNINGS":                     24 * time.Hour,
+		"EARNINGS_CALENDAR":            24 * time.Hour,
+		"LISTING_STATUS":               24 * time.Hour,
+		"IPO_CALENDAR":                 24 * time.Hour,
+		"REAL_GDP":                     24 * time.Hour,
+		"REAL_GDP_PER_CAPITA":          24 * time.Hour,
+		"TREASURY_YIELD":               24 * time.Hour,
+		"FEDERAL_FUNDS_RATE":           24 * time.Hour,
+		"CPI":                          24 * time.Hour,
+		"INFLATION":                    24 * time.Hour,
+		"RETAIL_SALES":                 24 * time.Hour,
+		"DURABLES":                     24 * time.Hour,
+		"UNEMPLOYMENT":                 24 * time.Hour,
+		"NONFARM_PAYROLL":              24 * time.Hour,
+	}
+}
+
+// cacheKey returns the canonical url of u without apikey
+func cacheKey(u *url.URL) string {
+	q := u.Query()
+	q.Del("apikey")
+
+	key := *u
+	key.RawQuery = q.Encode()
+	return key.String()
+}
+
+// cacheTTL returns the time to live of the responses of function, false if they are not cached
+func (s *Service) cacheTTL(function string) (time.Duration, bool) {
+	if s.Cache == nil {
+		return 0, false
+	}
+
+	ttls := s.CacheTTL
+	if ttls == nil {
+		ttls = DefaultCacheTTL()
+	}
+	ttl, ok := ttls[function]
+	return ttl, ok && ttl > 0
+}
+
+// sendCachedRequest returns the response cached for req unless refresh,
+// otherwise sends req and caches a successful response during ttl
+func (s *Service) sendCachedRequest(ctx context.Context, req *http.Request, ttl time.Duration, refresh bool) (*http.Response, error) {
+	key := cacheKey(req.URL)
+	if !refresh {
+		if b, ok := s.Cache.Get(key); ok {
+			res, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), req)
+			if err == nil {
+				return res, nil
+			}
+		}
+	}
+
+	res, err := s.sendRequest(ctx, req)
+	if err != nil || res.StatusCode < 200 || res.StatusCode > 299 {
+		return res, err
+	}
+
+	// the Error Message or Note of a json envelope is not cached
+	errReply, err := peekErrorReply(res)
+	if err != nil {
+		res.Body.Close()
+		return nil, errors.Wrapf(err, "peekErrorReply")
+	}
+	if errReply != nil {
+		return res, nil
+	}
+
+	b, err := httputil.DumpResponse(res, true)
+	if err != nil {
+		res.Body.Close()
+		return nil, errors.Wrapf(err, "httputil.DumpResponse")
+	}
+	s.Cache.Set(key, b, ttl)
+
+	return res, nil
+}
+
+// MemoryCache Cache in memory, evicting the least recently used response beyond its size
+type MemoryCache struct {
+	size int
+
+	mu      sync.Mutex
+	ll      *list.List
+	entries map[string]*list.Element
+
+	now func() time.Time
+}
+
+type memoryCacheEntry struct {
+	key      string
+	response []byte
+	expires  time.Time
+}
+
+// NewMemoryCache returns a MemoryCache holding at most size responses
+func NewMemoryCache(size int) *MemoryCache {
+	return &MemoryCache{
+		size:    size,
+		ll:      list.New(),
+		entries: make(map[string]*list.Element),
+		now:     time.Now,
+	}
+}
+
+// Get returns the response cached for key, false if there is none or it is expired
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*memoryCacheEntry)
+	if !m.now().Before(entry.expires) {
+		m.ll.Remove(e)
+		delete(m.entries, key)
+		return nil, false
+	}
+	m.ll.MoveToFront(e)
+
+	return entry.response, true
+}
+
+// Set caches the response for key during ttl
+func (m *MemoryCache) Set(key string, response []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := &memoryCacheEntry{key: key, response: response, expires: m.now().Add(ttl)}
+	if e, ok := m.entries[key]; ok {
+		e.Value = entry
+		m.ll.MoveToFront(e)
+		return
+	}
+	m.entries[key] = m.ll.PushFront(entry)
+
+	for m.size > 0 && m.ll.Len() > m.size {
+		e := m.ll.Back()
+		m.ll.Remove(e)
+		delete(m.entries, e.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Len returns the number of cached responses, including expired ones not evicted yet
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.ll.Len()
+}
+
+// FileCache Cache in files of a directory, shared by processes and kept across restarts.
+// Failing to write a file only misses the cache.
+type FileCache struct {
+	dir string
+
+	now func() time.Time
+}
+
+// NewFileCache returns a FileCache in dir, which is created on the first Set
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{dir: dir, now: time.Now}
+}
+
+// path returns the file of key, named by its hash since keys are urls
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get returns the response cached for key, false if there is none or it is expired
+func (f *FileCache) Get(key string) ([]byte, bool) {
+	b, err := ioutil.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	// the first line is the expiry in unix nanoseconds
+	i := bytes.IndexByte(b, '\n')
+	if i < 0 {
+		return nil, false
+	}
+	expires, err := strconv.ParseInt(string(b[:i]), 10, 64)
+	if err != nil || f.now().UnixNano() >= expires {
+		os.Remove(f.path(key))
+		return nil, false
+	}
+
+	return b[i+1:], true
+}
+
+// Set caches the response for key during ttl
+func (f *FileCache) Set(key string, response []byte, ttl time.Duration) {
+	if err := os.MkdirAll(f.dir, 0700); err != nil {
+		return
+	}
+
+	tmp, err := ioutil.TempFile(f.dir, "tmp-")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	expires := strconv.FormatInt(f.now().Add(ttl).UnixNano(), 10)
+	if _, err := tmp.WriteString(expires + "\n"); err != nil {
+		tmp.Close()
+		return
+	}
+	if _, err := tmp.Write(response); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+
+	// rename is atomic, so Get never reads a partial file
+	os.Rename(tmp.Name(), f.path(key))
+}
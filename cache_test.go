@@ -0,0 +1,190 @@
+package alphavantage
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	now := time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)
+	m := NewMemoryCache(2)
+	m.now = func() time.Time { return now }
+
+	m.Set("a", []byte("a"), time.Minute)
+	m.Set("b", []byte("b"), time.Hour)
+	if _, ok := m.Get("a"); !ok {
+		t.Fatalf("MemoryCache.Get(a) miss, want hit")
+	}
+
+	// a is used more recently than b, so b is evicted
+	m.Set("c", []byte("c"), time.Hour)
+	if _, ok := m.Get("b"); ok {
+		t.Errorf("MemoryCache.Get(b) hit, want evicted")
+	}
+	if got, ok := m.Get("c"); !ok || string(got) != "c" {
+		t.Errorf("MemoryCache.Get(c) = %s, %v, want c, true", got, ok)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := m.Get("a"); ok {
+		t.Errorf("MemoryCache.Get(a) hit, want expired")
+	}
+	if got := m.Len(); got != 1 {
+		t.Errorf("MemoryCache.Len() = %d, want 1", got)
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "alphavantage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)
+	f := NewFileCache(dir + "/cache")
+	f.now = func() time.Time { return now }
+
+	if _, ok := f.Get("a"); ok {
+		t.Fatalf("FileCache.Get(a) hit, want miss")
+	}
+	f.Set("a", []byte("line1\nline2"), time.Minute)
+	if got, ok := f.Get("a"); !ok || string(got) != "line1\nline2" {
+		t.Errorf("FileCache.Get(a) = %q, %v, want %q, true", got, ok, "line1\nline2")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := f.Get("a"); ok {
+		t.Errorf("FileCache.Get(a) hit, want expired")
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	u, _ := url.Parse("https://www.alphavantage.co/query?symbol=symbol&apikey=key&function=TIME_SERIES_DAILY")
+	want := "https://www.alphavantage.co/query?function=TIME_SERIES_DAILY&symbol=symbol"
+	if got := cacheKey(u); got != want {
+		t.Errorf("cacheKey() = %v, want %v", got, want)
+	}
+}
+
+func TestService_Cache(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []sequenceResponse
+		do        func(s *Service) (*TimeSeriesList, error)
+		wantCalls int
+		wantErr   error
+	}{
+		// TODO: Add test cases.
+		{"Hit", []sequenceResponse{{dailyBody, http.StatusOK}}, func(s *Service) (*TimeSeriesList, error) {
+			return s.TimeSeries.Daily("symbol").Do()
+		}, 1, nil},
+		{"SkipCache", []sequenceResponse{{dailyBody, http.StatusOK}}, func(s *Service) (*TimeSeriesList, error) {
+			c := s.TimeSeries.Daily("symbol")
+			c.SkipCache()
+			return c.Do()
+		}, 2, nil},
+		{"RefreshCache", []sequenceResponse{{dailyBody, http.StatusOK}}, func(s *Service) (*TimeSeriesList, error) {
+			c := s.TimeSeries.Daily("symbol")
+			c.RefreshCache()
+			return c.Do()
+		}, 2, nil},
+		{"Other Params", []sequenceResponse{{dailyBody, http.StatusOK}}, func(s *Service) (*TimeSeriesList, error) {
+			return s.TimeSeries.Daily("other").Do()
+		}, 2, nil},
+		{"No TTL", []sequenceResponse{{dailyBody, http.StatusOK}}, func(s *Service) (*TimeSeriesList, error) {
+			s.CacheTTL = map[string]time.Duration{"TIME_SERIES_MONTHLY": time.Hour}
+			return s.TimeSeries.Daily("symbol").Do()
+		}, 2, nil},
+		{"Note", []sequenceResponse{{noteBody, http.StatusOK}, {dailyBody, http.StatusOK}}, func(s *Service) (*TimeSeriesList, error) {
+			return s.TimeSeries.Daily("symbol").Do()
+		}, 2, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, st := clientSequence("key", tt.responses...)
+			avTest, _ := New(client)
+			avTest.Cache = NewMemoryCache(10)
+
+			// the first call fills the cache
+			first, _ := avTest.TimeSeries.Daily("symbol").Do()
+
+			got, err := tt.do(avTest)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TimeSeriesDailyCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (len(got.TimeSeries) != 1 || got.HTTPStatusCode != http.StatusOK) {
+				t.Errorf("TimeSeriesDailyCall.Do() = %+v, want 1 TimeSeries", got)
+			}
+			if first != nil && got != nil && !reflect.DeepEqual(got.TimeSeries, first.TimeSeries) {
+				t.Errorf("TimeSeriesDailyCall.Do() = %v, want %v", got.TimeSeries, first.TimeSeries)
+			}
+			if st.calls != tt.wantCalls {
+				t.Errorf("TimeSeriesDailyCall.Do() calls = %d, want %d", st.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// countingTransport returns body and counts how much of it has been read
+type countingTransport struct {
+	body string
+	read int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var res http.Response
+	res.StatusCode = http.StatusOK
+	res.Body = ioutil.NopCloser(&countingReader{r: strings.NewReader(t.body), n: &t.read})
+	res.Header = http.Header{}
+	res.Request = req
+
+	return &res, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n *int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	*r.n += n
+	return n, err
+}
+
+func TestDoStream_cache(t *testing.T) {
+	body := "timestamp,open,high,low,close,volume\n" +
+		strings.Repeat("2020-03-25 16:00:00,148.9800,149.1000,146.1600,146.8600,2666656\n", 1000)
+	ct := &countingTransport{body: body}
+	transport := newTransport("key")
+	transport.originalTransport = ct
+	avTest, _ := New(&http.Client{Transport: transport})
+	m := NewMemoryCache(10)
+	avTest.Cache = m
+
+	var readAtFirstRow int
+	_, err := avTest.TimeSeries.Intraday("symbol", TimeSeriesIntervalFiveMinute).DoStream(func(ts *TimeSeries) error {
+		if readAtFirstRow == 0 {
+			readAtFirstRow = ct.read
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("TimeSeriesIntradayCall.DoStream() error = %v", err)
+	}
+	if readAtFirstRow >= len(body) {
+		t.Errorf("TimeSeriesIntradayCall.DoStream() read %d bytes before the first row, want less than the body %d", readAtFirstRow, len(body))
+	}
+	if m.Len() != 0 {
+		t.Errorf("MemoryCache.Len() = %d, want %d", m.Len(), 0)
+	}
+}
@@ -1,5 +1,8 @@
 package alphavantage
 
+// timeZone is the time zone of the csv timestamps, which carry none
+const timeZone = "US/Eastern"
+
 // Stock Time Series
 const (
 	TimeSeriesIntervalOneMinute     = "1min"
@@ -9,4 +12,42 @@ const (
 	TimeSeriesIntervalOneHour       = "60min"
 	OutputSizeFull                  = "full"
 	OutputsizeCompact               = "compact"
+	DatatypeCSV                     = "csv"
+	DatatypeJSON                    = "json"
+)
+
+// Technical Indicators
+const (
+	IndicatorIntervalDaily   = "daily"
+	IndicatorIntervalWeekly  = "weekly"
+	IndicatorIntervalMonthly = "monthly"
+	SeriesTypeOpen           = "open"
+	SeriesTypeHigh           = "high"
+	SeriesTypeLow            = "low"
+	SeriesTypeClose          = "close"
+)
+
+// Fundamentals
+const (
+	EarningsHorizonThreeMonth  = "3month"
+	EarningsHorizonSixMonth    = "6month"
+	EarningsHorizonTwelveMonth = "12month"
+	ListingStateActive         = "active"
+	ListingStateDelisted       = "delisted"
+)
+
+// Economic Indicators
+const (
+	EconomicIntervalDaily      = "daily"
+	EconomicIntervalWeekly     = "weekly"
+	EconomicIntervalMonthly    = "monthly"
+	EconomicIntervalQuarterly  = "quarterly"
+	EconomicIntervalSemiannual = "semiannual"
+	EconomicIntervalAnnual     = "annual"
+	TreasuryMaturityThreeMonth = "3month"
+	TreasuryMaturityTwoYear    = "2year"
+	TreasuryMaturityFiveYear   = "5year"
+	TreasuryMaturitySevenYear  = "7year"
+	TreasuryMaturityTenYear    = "10year"
+	TreasuryMaturityThirtyYear = "30year"
 )
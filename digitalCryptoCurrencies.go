@@ -1,7 +1,12 @@
 package alphavantage
 
-// To do
-// https://www.alphavantage.co/documentation/#digital-currency
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 // NewDigitalCryptoCurrenciesService https://www.alphavantage.co/documentation/#digital-currency
 // APIs under this section provide a wide range of data feed for digital and crypto currencies such as Bitcoin.
@@ -14,4 +19,248 @@ func NewDigitalCryptoCurrenciesService(s *Service) *DigitalCryptoCurrenciesServi
 // APIs under this section provide a wide range of data feed for digital and crypto currencies such as Bitcoin.
 type DigitalCryptoCurrenciesService struct {
 	s *Service
-}
\ No newline at end of file
+}
+
+// Daily https://www.alphavantage.co/documentation/#currency-daily
+// This API returns the daily historical time series for a digital currency (e.g., BTC) traded on a specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC).
+// Prices and volumes are quoted in both the market-specific currency and USD.
+// datatype fixed to csv
+func (r *DigitalCryptoCurrenciesService) Daily(symbol, market string) *DigitalCurrencyDailyCall {
+	c := &DigitalCurrencyDailyCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		market: market,
+	}
+	c.urlParams.Set("function", "DIGITAL_CURRENCY_DAILY")
+	c.urlParams.Set("symbol", symbol)
+	c.urlParams.Set("market", market)
+	c.urlParams.Set("datatype", "csv")
+	return c
+}
+
+// DigitalCurrencyDailyCall https://www.alphavantage.co/documentation/#currency-daily
+// This API returns the daily historical time series for a digital currency (e.g., BTC) traded on a specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC).
+// Prices and volumes are quoted in both the market-specific currency and USD.
+// datatype fixed to csv
+type DigitalCurrencyDailyCall struct {
+	DefaultCall
+
+	market string
+}
+
+// Do send request
+func (c *DigitalCurrencyDailyCall) Do() (*CryptoSeriesList, error) {
+	// DIGITAL_CURRENCY_* timestamps are in UTC
+	target := new([]*CryptoSeries)
+	sr, err := c.do(func(res *http.Response) error {
+		return errors.Wrapf(decodeResponseCSVHeader(target, res, cryptoSeriesHeader(c.market), time.UTC), "decodeResponseCSVHeader")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &CryptoSeriesList{
+		ServerResponse: sr,
+		Market:         c.market,
+		CryptoSeries:   *target,
+	}
+
+	return ret, nil
+}
+
+// Weekly https://www.alphavantage.co/documentation/#currency-weekly
+// This API returns the weekly historical time series for a digital currency (e.g., BTC) traded on a specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC).
+// Prices and volumes are quoted in both the market-specific currency and USD.
+// datatype fixed to csv
+func (r *DigitalCryptoCurrenciesService) Weekly(symbol, market string) *DigitalCurrencyWeeklyCall {
+	c := &DigitalCurrencyWeeklyCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		market: market,
+	}
+	c.urlParams.Set("function", "DIGITAL_CURRENCY_WEEKLY")
+	c.urlParams.Set("symbol", symbol)
+	c.urlParams.Set("market", market)
+	c.urlParams.Set("datatype", "csv")
+	return c
+}
+
+// DigitalCurrencyWeeklyCall https://www.alphavantage.co/documentation/#currency-weekly
+// This API returns the weekly historical time series for a digital currency (e.g., BTC) traded on a specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC).
+// Prices and volumes are quoted in both the market-specific currency and USD.
+// datatype fixed to csv
+type DigitalCurrencyWeeklyCall struct {
+	DefaultCall
+
+	market string
+}
+
+// Do send request
+func (c *DigitalCurrencyWeeklyCall) Do() (*CryptoSeriesList, error) {
+	// DIGITAL_CURRENCY_* timestamps are in UTC
+	target := new([]*CryptoSeries)
+	sr, err := c.do(func(res *http.Response) error {
+		return errors.Wrapf(decodeResponseCSVHeader(target, res, cryptoSeriesHeader(c.market), time.UTC), "decodeResponseCSVHeader")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &CryptoSeriesList{
+		ServerResponse: sr,
+		Market:         c.market,
+		CryptoSeries:   *target,
+	}
+
+	return ret, nil
+}
+
+// Monthly https://www.alphavantage.co/documentation/#currency-monthly
+// This API returns the monthly historical time series for a digital currency (e.g., BTC) traded on a specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC).
+// Prices and volumes are quoted in both the market-specific currency and USD.
+// datatype fixed to csv
+func (r *DigitalCryptoCurrenciesService) Monthly(symbol, market string) *DigitalCurrencyMonthlyCall {
+	c := &DigitalCurrencyMonthlyCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		market: market,
+	}
+	c.urlParams.Set("function", "DIGITAL_CURRENCY_MONTHLY")
+	c.urlParams.Set("symbol", symbol)
+	c.urlParams.Set("market", market)
+	c.urlParams.Set("datatype", "csv")
+	return c
+}
+
+// DigitalCurrencyMonthlyCall https://www.alphavantage.co/documentation/#currency-monthly
+// This API returns the monthly historical time series for a digital currency (e.g., BTC) traded on a specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC).
+// Prices and volumes are quoted in both the market-specific currency and USD.
+// datatype fixed to csv
+type DigitalCurrencyMonthlyCall struct {
+	DefaultCall
+
+	market string
+}
+
+// Do send request
+func (c *DigitalCurrencyMonthlyCall) Do() (*CryptoSeriesList, error) {
+	// DIGITAL_CURRENCY_* timestamps are in UTC
+	target := new([]*CryptoSeries)
+	sr, err := c.do(func(res *http.Response) error {
+		return errors.Wrapf(decodeResponseCSVHeader(target, res, cryptoSeriesHeader(c.market), time.UTC), "decodeResponseCSVHeader")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &CryptoSeriesList{
+		ServerResponse: sr,
+		Market:         c.market,
+		CryptoSeries:   *target,
+	}
+
+	return ret, nil
+}
+
+// Intraday https://www.alphavantage.co/documentation/#crypto-intraday
+// This API returns intraday time series (timestamp, open, high, low, close, volume) of the cryptocurrency specified, updated realtime.
+// datatype fixed to csv
+func (r *DigitalCryptoCurrenciesService) Intraday(symbol, market, interval string) *DigitalCurrencyIntradayCall {
+	c := &DigitalCurrencyIntradayCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", "CRYPTO_INTRADAY")
+	c.urlParams.Set("symbol", symbol)
+	c.urlParams.Set("market", market)
+	c.urlParams.Set("interval", interval)
+	c.urlParams.Set("datatype", "csv")
+	return c
+}
+
+// DigitalCurrencyIntradayCall https://www.alphavantage.co/documentation/#crypto-intraday
+// This API returns intraday time series (timestamp, open, high, low, close, volume) of the cryptocurrency specified, updated realtime.
+// datatype fixed to csv
+type DigitalCurrencyIntradayCall struct {
+	DefaultCall
+}
+
+// Outputsize By default, outputsize=compact.
+// Strings compact and full are accepted with the following specifications:
+// compact returns only the latest 100 data points;
+// full returns the full-length intraday time series.
+// The "compact" option is recommended if you would like to reduce the data size of each API call.
+func (c *DigitalCurrencyIntradayCall) Outputsize(outputsize string) *DigitalCurrencyIntradayCall {
+	c.urlParams.Set("outputsize", outputsize)
+
+	return c
+}
+
+// Do send request
+func (c *DigitalCurrencyIntradayCall) Do() (*TimeSeriesList, error) {
+	// CRYPTO_INTRADAY timestamps are in UTC
+	target := new([]*TimeSeries)
+	sr, err := c.doCSVIn(target, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &TimeSeriesList{
+		ServerResponse: sr,
+		Meta:           newMeta(c.urlParams, "UTC"),
+		TimeSeries:     *target,
+	}
+
+	return ret, nil
+}
+
+// Rating https://www.alphavantage.co/documentation/#crypto-ratings
+// This API returns the Fundamental Crypto Asset Score (FCAS), a comparative measure of the fundamental health of crypto projects.
+// datatype fixed to json
+func (r *DigitalCryptoCurrenciesService) Rating(symbol string) *DigitalCurrencyRatingCall {
+	c := &DigitalCurrencyRatingCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol: symbol,
+	}
+	c.urlParams.Set("function", "CRYPTO_RATING")
+	c.urlParams.Set("symbol", symbol)
+	return c
+}
+
+// DigitalCurrencyRatingCall https://www.alphavantage.co/documentation/#crypto-ratings
+// This API returns the Fundamental Crypto Asset Score (FCAS), a comparative measure of the fundamental health of crypto projects.
+// datatype fixed to json
+type DigitalCurrencyRatingCall struct {
+	DefaultCall
+
+	symbol string
+}
+
+// Do send request
+func (c *DigitalCurrencyRatingCall) Do() (*CryptoRating, error) {
+	target := new(cryptoRatingReply)
+	sr, err := c.doJSONFound(target, c.symbol, func() bool { return target.CryptoRating != nil })
+	if err != nil {
+		return nil, err
+	}
+
+	ret := target.CryptoRating
+	ret.ServerResponse = sr
+
+	return ret, nil
+}
@@ -0,0 +1,105 @@
+package alphavantage
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CryptoSeries digital currency time series
+// Prices are quoted both in the market currency and in USD
+type CryptoSeries struct {
+	Time Time `csv:"timestamp"`
+
+	// market currency
+	OpenMarket  float64 `csv:"open (market)"`
+	HighMarket  float64 `csv:"high (market)"`
+	LowMarket   float64 `csv:"low (market)"`
+	CloseMarket float64 `csv:"close (market)"`
+
+	// USD
+	OpenUSD  float64 `csv:"open (USD)"`
+	HighUSD  float64 `csv:"high (USD)"`
+	LowUSD   float64 `csv:"low (USD)"`
+	CloseUSD float64 `csv:"close (USD)"`
+
+	Volume       float64 `csv:"volume"`
+	MarketCapUSD float64 `csv:"market cap (USD)"`
+}
+
+// CryptoSeriesList CryptoSeries List
+type CryptoSeriesList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	// Market is the currency the Market columns are quoted in
+	Market string
+
+	CryptoSeries []*CryptoSeries
+}
+
+// cryptoSeriesHeader renames the market columns, e.g. "open (CNY)", to "open (market)".
+// The market columns come first, so with market USD only the first of the duplicated columns is renamed.
+func cryptoSeriesHeader(market string) func([]string) []string {
+	suffix := " (" + market + ")"
+	return func(header []string) []string {
+		renamed := make([]string, len(header))
+		seen := make(map[string]bool)
+		for i, h := range header {
+			renamed[i] = h
+			if !strings.HasSuffix(h, suffix) || h == "market cap (USD)" {
+				continue
+			}
+			name := strings.TrimSuffix(h, suffix) + " (market)"
+			if !seen[name] {
+				renamed[i] = name
+				seen[name] = true
+			}
+		}
+
+		return renamed
+	}
+}
+
+// CryptoRating Fundamental Crypto Asset Score (FCAS) of a digital currency
+type CryptoRating struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+
+	Symbol              string  `json:"1. symbol"`
+	Name                string  `json:"2. name"`
+	FCASRating          string  `json:"3. fcas rating"`
+	FCASScore           float64 `json:"4. fcas score,string"`
+	DeveloperScore      float64 `json:"5. developer score,string"`
+	MarketMaturityScore float64 `json:"6. market maturity score,string"`
+	UtilityScore        float64 `json:"7. utility score,string"`
+	LastRefreshed       Time    `json:"8. last refreshed"`
+	TimeZone            string  `json:"9. timezone"`
+}
+
+// UnmarshalJSON parses LastRefreshed in TimeZone
+func (r *CryptoRating) UnmarshalJSON(data []byte) error {
+	type cryptoRating CryptoRating
+	v := struct {
+		*cryptoRating
+		LastRefreshed string `json:"8. last refreshed"`
+	}{cryptoRating: (*cryptoRating)(r)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	t, err := timeInZone(v.LastRefreshed, r.TimeZone)
+	if err != nil {
+		return errors.Wrapf(err, "timeInZone")
+	}
+	r.LastRefreshed = t
+
+	return nil
+}
+
+type cryptoRatingReply struct {
+	CryptoRating *CryptoRating `json:"Crypto Rating (FCAS)"`
+}
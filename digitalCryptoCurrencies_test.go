@@ -0,0 +1,351 @@
+package alphavantage
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDigitalCurrencyDailyCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *DigitalCurrencyDailyCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewDigitalCryptoCurrenciesService(avTest).Daily("BTC", "CNY"), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=DIGITAL_CURRENCY_DAILY&market=CNY&symbol=BTC", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DigitalCurrencyDailyCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitalCurrencyDailyCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigitalCurrencyDailyCall_Do(t *testing.T) {
+	client := clientTest("key", `timestamp,open (CNY),high (CNY),low (CNY),close (CNY),open (USD),high (USD),low (USD),close (USD),volume,market cap (USD)
+2020-04-02,44003.2000,45101.6400,43622.9100,44839.1600,6210.5000,6365.5300,6156.7900,6328.4200,57208.0500,57208.0500`, http.StatusOK)
+	avTest, _ := New(client)
+	clientUSD := clientTest("key", `timestamp,open (USD),high (USD),low (USD),close (USD),open (USD),high (USD),low (USD),close (USD),volume,market cap (USD)
+2020-04-02,6210.5000,6365.5300,6156.7900,6328.4200,6210.5000,6365.5300,6156.7900,6328.4200,57208.0500,57208.0500`, http.StatusOK)
+	avUSD, _ := New(clientUSD)
+
+	tests := []struct {
+		name    string
+		c       *DigitalCurrencyDailyCall
+		want    []*CryptoSeries
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"CNY", NewDigitalCryptoCurrenciesService(avTest).Daily("BTC", "CNY"), []*CryptoSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)), OpenMarket: 44003.2, HighMarket: 45101.64, LowMarket: 43622.91, CloseMarket: 44839.16, OpenUSD: 6210.5, HighUSD: 6365.53, LowUSD: 6156.79, CloseUSD: 6328.42, Volume: 57208.05, MarketCapUSD: 57208.05},
+		}, false},
+		{"USD", NewDigitalCryptoCurrenciesService(avUSD).Daily("BTC", "USD"), []*CryptoSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)), OpenMarket: 6210.5, HighMarket: 6365.53, LowMarket: 6156.79, CloseMarket: 6328.42, OpenUSD: 6210.5, HighUSD: 6365.53, LowUSD: 6156.79, CloseUSD: 6328.42, Volume: 57208.05, MarketCapUSD: 57208.05},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DigitalCurrencyDailyCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.CryptoSeries
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitalCurrencyDailyCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigitalCurrencyWeeklyCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *DigitalCurrencyWeeklyCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewDigitalCryptoCurrenciesService(avTest).Weekly("BTC", "CNY"), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=DIGITAL_CURRENCY_WEEKLY&market=CNY&symbol=BTC", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DigitalCurrencyWeeklyCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitalCurrencyWeeklyCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigitalCurrencyWeeklyCall_Do(t *testing.T) {
+	client := clientTest("key", `timestamp,open (CNY),high (CNY),low (CNY),close (CNY),open (USD),high (USD),low (USD),close (USD),volume,market cap (USD)
+2020-04-02,44003.2000,45101.6400,43622.9100,44839.1600,6210.5000,6365.5300,6156.7900,6328.4200,57208.0500,57208.0500`, http.StatusOK)
+	avTest, _ := New(client)
+	clientUSD := clientTest("key", `timestamp,open (USD),high (USD),low (USD),close (USD),open (USD),high (USD),low (USD),close (USD),volume,market cap (USD)
+2020-04-02,6210.5000,6365.5300,6156.7900,6328.4200,6210.5000,6365.5300,6156.7900,6328.4200,57208.0500,57208.0500`, http.StatusOK)
+	avUSD, _ := New(clientUSD)
+
+	tests := []struct {
+		name    string
+		c       *DigitalCurrencyWeeklyCall
+		want    []*CryptoSeries
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"CNY", NewDigitalCryptoCurrenciesService(avTest).Weekly("BTC", "CNY"), []*CryptoSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)), OpenMarket: 44003.2, HighMarket: 45101.64, LowMarket: 43622.91, CloseMarket: 44839.16, OpenUSD: 6210.5, HighUSD: 6365.53, LowUSD: 6156.79, CloseUSD: 6328.42, Volume: 57208.05, MarketCapUSD: 57208.05},
+		}, false},
+		{"USD", NewDigitalCryptoCurrenciesService(avUSD).Weekly("BTC", "USD"), []*CryptoSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)), OpenMarket: 6210.5, HighMarket: 6365.53, LowMarket: 6156.79, CloseMarket: 6328.42, OpenUSD: 6210.5, HighUSD: 6365.53, LowUSD: 6156.79, CloseUSD: 6328.42, Volume: 57208.05, MarketCapUSD: 57208.05},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DigitalCurrencyWeeklyCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.CryptoSeries
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitalCurrencyWeeklyCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigitalCurrencyMonthlyCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *DigitalCurrencyMonthlyCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewDigitalCryptoCurrenciesService(avTest).Monthly("BTC", "CNY"), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=DIGITAL_CURRENCY_MONTHLY&market=CNY&symbol=BTC", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DigitalCurrencyMonthlyCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitalCurrencyMonthlyCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigitalCurrencyMonthlyCall_Do(t *testing.T) {
+	client := clientTest("key", `timestamp,open (CNY),high (CNY),low (CNY),close (CNY),open (USD),high (USD),low (USD),close (USD),volume,market cap (USD)
+2020-04-02,44003.2000,45101.6400,43622.9100,44839.1600,6210.5000,6365.5300,6156.7900,6328.4200,57208.0500,57208.0500`, http.StatusOK)
+	avTest, _ := New(client)
+	clientUSD := clientTest("key", `timestamp,open (USD),high (USD),low (USD),close (USD),open (USD),high (USD),low (USD),close (USD),volume,market cap (USD)
+2020-04-02,6210.5000,6365.5300,6156.7900,6328.4200,6210.5000,6365.5300,6156.7900,6328.4200,57208.0500,57208.0500`, http.StatusOK)
+	avUSD, _ := New(clientUSD)
+
+	tests := []struct {
+		name    string
+		c       *DigitalCurrencyMonthlyCall
+		want    []*CryptoSeries
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"CNY", NewDigitalCryptoCurrenciesService(avTest).Monthly("BTC", "CNY"), []*CryptoSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)), OpenMarket: 44003.2, HighMarket: 45101.64, LowMarket: 43622.91, CloseMarket: 44839.16, OpenUSD: 6210.5, HighUSD: 6365.53, LowUSD: 6156.79, CloseUSD: 6328.42, Volume: 57208.05, MarketCapUSD: 57208.05},
+		}, false},
+		{"USD", NewDigitalCryptoCurrenciesService(avUSD).Monthly("BTC", "USD"), []*CryptoSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)), OpenMarket: 6210.5, HighMarket: 6365.53, LowMarket: 6156.79, CloseMarket: 6328.42, OpenUSD: 6210.5, HighUSD: 6365.53, LowUSD: 6156.79, CloseUSD: 6328.42, Volume: 57208.05, MarketCapUSD: 57208.05},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DigitalCurrencyMonthlyCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.CryptoSeries
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitalCurrencyMonthlyCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigitalCurrencyIntradayCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *DigitalCurrencyIntradayCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"1min", NewDigitalCryptoCurrenciesService(avTest).Intraday("ETH", "USD", TimeSeriesIntervalOneMinute), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=CRYPTO_INTRADAY&interval=1min&market=USD&symbol=ETH", false},
+		{"5min", NewDigitalCryptoCurrenciesService(avTest).Intraday("ETH", "USD", TimeSeriesIntervalFiveMinute), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=CRYPTO_INTRADAY&interval=5min&market=USD&symbol=ETH", false},
+		{"15min", NewDigitalCryptoCurrenciesService(avTest).Intraday("ETH", "USD", TimeSeriesIntervalFifteenMinute), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=CRYPTO_INTRADAY&interval=15min&market=USD&symbol=ETH", false},
+		{"30min", NewDigitalCryptoCurrenciesService(avTest).Intraday("ETH", "USD", TimeSeriesIntervalThirtyMinute), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=CRYPTO_INTRADAY&interval=30min&market=USD&symbol=ETH", false},
+		{"60min", NewDigitalCryptoCurrenciesService(avTest).Intraday("ETH", "USD", TimeSeriesIntervalOneHour), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=CRYPTO_INTRADAY&interval=60min&market=USD&symbol=ETH", false},
+		{"full", NewDigitalCryptoCurrenciesService(avTest).Intraday("ETH", "USD", TimeSeriesIntervalOneMinute).Outputsize(OutputSizeFull), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=CRYPTO_INTRADAY&interval=1min&market=USD&outputsize=full&symbol=ETH", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DigitalCurrencyIntradayCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitalCurrencyIntradayCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigitalCurrencyIntradayCall_Do(t *testing.T) {
+	client := clientTest("key", `timestamp,open,high,low,close,volume
+2020-04-02 16:00:00,141.2700,141.5100,141.1200,141.3000,1021
+2020-03-08 02:30:00,141.2700,141.5100,141.1200,141.3000,1021`, http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *DigitalCurrencyIntradayCall
+		want    []*TimeSeries
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewDigitalCryptoCurrenciesService(avTest).Intraday("ETH", "USD", TimeSeriesIntervalOneMinute), []*TimeSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 16, 0, 0, 0, time.UTC)), Open: 141.27, High: 141.51, Low: 141.12, Close: 141.3, Volume: 1021},
+			// in the gap of the US/Eastern spring forward
+			{Time: Time(time.Date(2020, time.March, 8, 2, 30, 0, 0, time.UTC)), Open: 141.27, High: 141.51, Low: 141.12, Close: 141.3, Volume: 1021},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DigitalCurrencyIntradayCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.TimeSeries
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitalCurrencyIntradayCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigitalCurrencyRatingCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *DigitalCurrencyRatingCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewDigitalCryptoCurrenciesService(avTest).Rating("BTC"), "https://www.alphavantage.co/query?apikey=key&function=CRYPTO_RATING&symbol=BTC", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DigitalCurrencyRatingCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitalCurrencyRatingCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigitalCurrencyRatingCall_Do(t *testing.T) {
+	client := clientTest("key", `{
+    "Crypto Rating (FCAS)": {
+        "1. symbol": "BTC",
+        "2. name": "Bitcoin",
+        "3. fcas rating": "Superb",
+        "4. fcas score": "930",
+        "5. developer score": "865",
+        "6. market maturity score": "849",
+        "7. utility score": "973",
+        "8. last refreshed": "2020-03-08 02:30:00",
+        "9. timezone": "UTC"
+    }
+}`, http.StatusOK)
+	avTest, _ := New(client)
+	clientEmpty := clientTest("key", `{}`, http.StatusOK)
+	avEmpty, _ := New(clientEmpty)
+
+	tests := []struct {
+		name    string
+		c       *DigitalCurrencyRatingCall
+		want    *CryptoRating
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewDigitalCryptoCurrenciesService(avTest).Rating("BTC"), &CryptoRating{
+			ServerResponse:      ServerResponse{HTTPStatusCode: 200, Header: http.Header{}},
+			Symbol:              "BTC",
+			Name:                "Bitcoin",
+			FCASRating:          "Superb",
+			FCASScore:           930,
+			DeveloperScore:      865,
+			MarketMaturityScore: 849,
+			UtilityScore:        973,
+			LastRefreshed:       Time(time.Date(2020, time.March, 8, 2, 30, 0, 0, time.UTC)),
+			TimeZone:            "UTC",
+		}, false},
+		{"Empty", NewDigitalCryptoCurrenciesService(avEmpty).Rating("XXX"), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DigitalCurrencyRatingCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DigitalCurrencyRatingCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
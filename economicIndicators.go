@@ -0,0 +1,253 @@
+package alphavantage
+
+import (
+	"net/url"
+)
+
+// NewEconomicIndicatorsService https://www.alphavantage.co/documentation/#economic-indicators
+// APIs under this section provide key US economic indicators frequently used for investment strategy formulation and application development.
+func NewEconomicIndicatorsService(s *Service) *EconomicIndicatorsService {
+	rs := &EconomicIndicatorsService{s: s}
+	return rs
+}
+
+// EconomicIndicatorsService https://www.alphavantage.co/documentation/#economic-indicators
+// APIs under this section provide key US economic indicators frequently used for investment strategy formulation and application development.
+type EconomicIndicatorsService struct {
+	s *Service
+}
+
+func (r *EconomicIndicatorsService) newEconomicIndicatorCall(function string) EconomicIndicatorCall {
+	c := EconomicIndicatorCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", function)
+	return c
+}
+
+// EconomicIndicatorCall https://www.alphavantage.co/documentation/#economic-indicators
+// This API returns the name, interval, unit and dated values of an economic indicator.
+// datatype fixed to json
+type EconomicIndicatorCall struct {
+	DefaultCall
+}
+
+// Do send request
+func (c *EconomicIndicatorCall) Do() (*EconomicSeries, error) {
+	ret := &EconomicSeries{}
+	sr, err := c.doJSONFound(ret, c.urlParams.Get("function"), func() bool { return ret.Name != "" || len(ret.Data) != 0 })
+	if err != nil {
+		return nil, err
+	}
+	ret.ServerResponse = sr
+
+	return ret, nil
+}
+
+// RealGDP https://www.alphavantage.co/documentation/#real-gdp
+// This API returns the annual and quarterly Real GDP of the United States.
+// datatype fixed to json
+func (r *EconomicIndicatorsService) RealGDP() *EconomicRealGDPCall {
+	c := &EconomicRealGDPCall{
+		EconomicIndicatorCall: r.newEconomicIndicatorCall("REAL_GDP"),
+	}
+	return c
+}
+
+// EconomicRealGDPCall https://www.alphavantage.co/documentation/#real-gdp
+// This API returns the annual and quarterly Real GDP of the United States.
+// datatype fixed to json
+type EconomicRealGDPCall struct {
+	EconomicIndicatorCall
+}
+
+// Interval Strings quarterly, annual. By default, interval=annual.
+func (c *EconomicRealGDPCall) Interval(interval string) *EconomicRealGDPCall {
+	c.urlParams.Set("interval", interval)
+
+	return c
+}
+
+// RealGDPPerCapita https://www.alphavantage.co/documentation/#real-gdp-per-capita
+// This API returns the quarterly Real GDP per Capita data of the United States.
+// datatype fixed to json
+func (r *EconomicIndicatorsService) RealGDPPerCapita() *EconomicRealGDPPerCapitaCall {
+	c := &EconomicRealGDPPerCapitaCall{
+		EconomicIndicatorCall: r.newEconomicIndicatorCall("REAL_GDP_PER_CAPITA"),
+	}
+	return c
+}
+
+// EconomicRealGDPPerCapitaCall https://www.alphavantage.co/documentation/#real-gdp-per-capita
+// This API returns the quarterly Real GDP per Capita data of the United States.
+// datatype fixed to json
+type EconomicRealGDPPerCapitaCall struct {
+	EconomicIndicatorCall
+}
+
+// TreasuryYield https://www.alphavantage.co/documentation/#treasury-yield
+// This API returns the daily, weekly, and monthly US treasury yield of a given maturity timeline (e.g., 5 year, 30 year, etc).
+// datatype fixed to json
+func (r *EconomicIndicatorsService) TreasuryYield() *EconomicTreasuryYieldCall {
+	c := &EconomicTreasuryYieldCall{
+		EconomicIndicatorCall: r.newEconomicIndicatorCall("TREASURY_YIELD"),
+	}
+	return c
+}
+
+// EconomicTreasuryYieldCall https://www.alphavantage.co/documentation/#treasury-yield
+// This API returns the daily, weekly, and monthly US treasury yield of a given maturity timeline (e.g., 5 year, 30 year, etc).
+// datatype fixed to json
+type EconomicTreasuryYieldCall struct {
+	EconomicIndicatorCall
+}
+
+// Interval Strings daily, weekly, monthly. By default, interval=monthly.
+func (c *EconomicTreasuryYieldCall) Interval(interval string) *EconomicTreasuryYieldCall {
+	c.urlParams.Set("interval", interval)
+
+	return c
+}
+
+// Maturity Strings 3month, 2year, 5year, 7year, 10year, and 30year are accepted. By default, maturity=10year.
+func (c *EconomicTreasuryYieldCall) Maturity(maturity string) *EconomicTreasuryYieldCall {
+	c.urlParams.Set("maturity", maturity)
+
+	return c
+}
+
+// FederalFundsRate https://www.alphavantage.co/documentation/#interest-rate
+// This API returns the daily, weekly, and monthly federal funds rate (interest rate) of the United States.
+// datatype fixed to json
+func (r *EconomicIndicatorsService) FederalFundsRate() *EconomicFederalFundsRateCall {
+	c := &EconomicFederalFundsRateCall{
+		EconomicIndicatorCall: r.newEconomicIndicatorCall("FEDERAL_FUNDS_RATE"),
+	}
+	return c
+}
+
+// EconomicFederalFundsRateCall https://www.alphavantage.co/documentation/#interest-rate
+// This API returns the daily, weekly, and monthly federal funds rate (interest rate) of the United States.
+// datatype fixed to json
+type EconomicFederalFundsRateCall struct {
+	EconomicIndicatorCall
+}
+
+// Interval Strings daily, weekly, monthly. By default, interval=monthly.
+func (c *EconomicFederalFundsRateCall) Interval(interval string) *EconomicFederalFundsRateCall {
+	c.urlParams.Set("interval", interval)
+
+	return c
+}
+
+// CPI https://www.alphavantage.co/documentation/#cpi
+// This API returns the monthly and semiannual consumer price index (CPI) of the United States.
+// datatype fixed to json
+func (r *EconomicIndicatorsService) CPI() *EconomicCPICall {
+	c := &EconomicCPICall{
+		EconomicIndicatorCall: r.newEconomicIndicatorCall("CPI"),
+	}
+	return c
+}
+
+// EconomicCPICall https://www.alphavantage.co/documentation/#cpi
+// This API returns the monthly and semiannual consumer price index (CPI) of the United States.
+// datatype fixed to json
+type EconomicCPICall struct {
+	EconomicIndicatorCall
+}
+
+// Interval Strings monthly, semiannual. By default, interval=monthly.
+func (c *EconomicCPICall) Interval(interval string) *EconomicCPICall {
+	c.urlParams.Set("interval", interval)
+
+	return c
+}
+
+// Inflation https://www.alphavantage.co/documentation/#inflation
+// This API returns the annual inflation rates (consumer prices) of the United States.
+// datatype fixed to json
+func (r *EconomicIndicatorsService) Inflation() *EconomicInflationCall {
+	c := &EconomicInflationCall{
+		EconomicIndicatorCall: r.newEconomicIndicatorCall("INFLATION"),
+	}
+	return c
+}
+
+// EconomicInflationCall https://www.alphavantage.co/documentation/#inflation
+// This API returns the annual inflation rates (consumer prices) of the United States.
+// datatype fixed to json
+type EconomicInflationCall struct {
+	EconomicIndicatorCall
+}
+
+// RetailSales https://www.alphavantage.co/documentation/#retail-sales
+// This API returns the monthly Advance Retail Sales: Retail Trade data of the United States.
+// datatype fixed to json
+func (r *EconomicIndicatorsService) RetailSales() *EconomicRetailSalesCall {
+	c := &EconomicRetailSalesCall{
+		EconomicIndicatorCall: r.newEconomicIndicatorCall("RETAIL_SALES"),
+	}
+	return c
+}
+
+// EconomicRetailSalesCall https://www.alphavantage.co/documentation/#retail-sales
+// This API returns the monthly Advance Retail Sales: Retail Trade data of the United States.
+// datatype fixed to json
+type EconomicRetailSalesCall struct {
+	EconomicIndicatorCall
+}
+
+// Durables https://www.alphavantage.co/documentation/#durable-goods
+// This API returns the monthly manufacturers' new orders of durable goods in the United States.
+// datatype fixed to json
+func (r *EconomicIndicatorsService) Durables() *EconomicDurablesCall {
+	c := &EconomicDurablesCall{
+		EconomicIndicatorCall: r.newEconomicIndicatorCall("DURABLES"),
+	}
+	return c
+}
+
+// EconomicDurablesCall https://www.alphavantage.co/documentation/#durable-goods
+// This API returns the monthly manufacturers' new orders of durable goods in the United States.
+// datatype fixed to json
+type EconomicDurablesCall struct {
+	EconomicIndicatorCall
+}
+
+// Unemployment https://www.alphavantage.co/documentation/#unemployment
+// This API returns the monthly unemployment data of the United States.
+// datatype fixed to json
+func (r *EconomicIndicatorsService) Unemployment() *EconomicUnemploymentCall {
+	c := &EconomicUnemploymentCall{
+		EconomicIndicatorCall: r.newEconomicIndicatorCall("UNEMPLOYMENT"),
+	}
+	return c
+}
+
+// EconomicUnemploymentCall https://www.alphavantage.co/documentation/#unemployment
+// This API returns the monthly unemployment data of the United States.
+// datatype fixed to json
+type EconomicUnemploymentCall struct {
+	EconomicIndicatorCall
+}
+
+// NonfarmPayroll https://www.alphavantage.co/documentation/#nonfarm-payroll
+// This API returns the monthly US All Employees: Total Nonfarm (commonly known as Total Nonfarm Payroll).
+// datatype fixed to json
+func (r *EconomicIndicatorsService) NonfarmPayroll() *EconomicNonfarmPayrollCall {
+	c := &EconomicNonfarmPayrollCall{
+		EconomicIndicatorCall: r.newEconomicIndicatorCall("NONFARM_PAYROLL"),
+	}
+	return c
+}
+
+// EconomicNonfarmPayrollCall https://www.alphavantage.co/documentation/#nonfarm-payroll
+// This API returns the monthly US All Employees: Total Nonfarm (commonly known as Total Nonfarm Payroll).
+// datatype fixed to json
+type EconomicNonfarmPayrollCall struct {
+	EconomicIndicatorCall
+}
@@ -0,0 +1,19 @@
+package alphavantage
+
+// EconomicData value of an economic indicator at date
+type EconomicData struct {
+	Date  Time      `json:"date"`
+	Value NullFloat `json:"value"`
+}
+
+// EconomicSeries economic indicator series
+type EconomicSeries struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+
+	Name     string          `json:"name"`
+	Interval string          `json:"interval"`
+	Unit     string          `json:"unit"`
+	Data     []*EconomicData `json:"data"`
+}
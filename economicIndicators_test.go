@@ -0,0 +1,104 @@
+package alphavantage
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEconomicIndicatorCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *EconomicIndicatorCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"RealGDP", &NewEconomicIndicatorsService(avTest).RealGDP().Interval(EconomicIntervalQuarterly).EconomicIndicatorCall, "https://www.alphavantage.co/query?apikey=key&function=REAL_GDP&interval=quarterly", false},
+		{"RealGDPPerCapita", &NewEconomicIndicatorsService(avTest).RealGDPPerCapita().EconomicIndicatorCall, "https://www.alphavantage.co/query?apikey=key&function=REAL_GDP_PER_CAPITA", false},
+		{"TreasuryYield", &NewEconomicIndicatorsService(avTest).TreasuryYield().Interval(EconomicIntervalDaily).Maturity(TreasuryMaturityThirtyYear).EconomicIndicatorCall, "https://www.alphavantage.co/query?apikey=key&function=TREASURY_YIELD&interval=daily&maturity=30year", false},
+		{"FederalFundsRate", &NewEconomicIndicatorsService(avTest).FederalFundsRate().Interval(EconomicIntervalWeekly).EconomicIndicatorCall, "https://www.alphavantage.co/query?apikey=key&function=FEDERAL_FUNDS_RATE&interval=weekly", false},
+		{"CPI", &NewEconomicIndicatorsService(avTest).CPI().Interval(EconomicIntervalSemiannual).EconomicIndicatorCall, "https://www.alphavantage.co/query?apikey=key&function=CPI&interval=semiannual", false},
+		{"Inflation", &NewEconomicIndicatorsService(avTest).Inflation().EconomicIndicatorCall, "https://www.alphavantage.co/query?apikey=key&function=INFLATION", false},
+		{"RetailSales", &NewEconomicIndicatorsService(avTest).RetailSales().EconomicIndicatorCall, "https://www.alphavantage.co/query?apikey=key&function=RETAIL_SALES", false},
+		{"Durables", &NewEconomicIndicatorsService(avTest).Durables().EconomicIndicatorCall, "https://www.alphavantage.co/query?apikey=key&function=DURABLES", false},
+		{"Unemployment", &NewEconomicIndicatorsService(avTest).Unemployment().EconomicIndicatorCall, "https://www.alphavantage.co/query?apikey=key&function=UNEMPLOYMENT", false},
+		{"NonfarmPayroll", &NewEconomicIndicatorsService(avTest).NonfarmPayroll().EconomicIndicatorCall, "https://www.alphavantage.co/query?apikey=key&function=NONFARM_PAYROLL", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EconomicIndicatorCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EconomicIndicatorCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEconomicIndicatorCall_Do(t *testing.T) {
+	client := clientTest("key", `{
+    "name": "10-Year Treasury Constant Maturity Rate",
+    "interval": "daily",
+    "unit": "percent",
+    "data": [
+        {
+            "date": "2021-04-05",
+            "value": "1.72"
+        },
+        {
+            "date": "2021-04-02",
+            "value": "."
+        }
+    ]
+}`, http.StatusOK)
+	avTest, _ := New(client)
+	clientError := clientTest("key", errorBody, http.StatusOK)
+	avError, _ := New(clientError)
+	clientEmpty := clientTest("key", `{}`, http.StatusOK)
+	avEmpty, _ := New(clientEmpty)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *EconomicTreasuryYieldCall
+		want    *EconomicSeries
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewEconomicIndicatorsService(avTest).TreasuryYield().Interval(EconomicIntervalDaily), &EconomicSeries{
+			Name:     "10-Year Treasury Constant Maturity Rate",
+			Interval: "daily",
+			Unit:     "percent",
+			Data: []*EconomicData{
+				{Date: Time(time.Date(2021, time.April, 5, 0, 0, 0, 0, eastern)), Value: NullFloat{1.72, true}},
+				{Date: Time(time.Date(2021, time.April, 2, 0, 0, 0, 0, eastern))},
+			},
+		}, false},
+		{"Error Message", NewEconomicIndicatorsService(avError).TreasuryYield(), nil, true},
+		{"Empty", NewEconomicIndicatorsService(avEmpty).TreasuryYield(), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EconomicTreasuryYieldCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != nil {
+				got.ServerResponse = ServerResponse{}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EconomicTreasuryYieldCall.Do() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
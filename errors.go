@@ -2,11 +2,25 @@ package alphavantage
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 )
 
+// Errors reported by the server in a json envelope with HTTP 200,
+// an *Error wraps one of them so that callers can use errors.Is
+var (
+	// ErrRateLimited the "Note" about the API call frequency
+	ErrRateLimited = errors.New("rate limited")
+	// ErrInvalidAPICall the "Error Message" about an invalid API call
+	ErrInvalidAPICall = errors.New("invalid API call")
+	// ErrInformation the "Information", e.g. about a premium endpoint
+	ErrInformation = errors.New("information")
+	// ErrNoAPIKey a request of a client without apikey, which is not sent
+	ErrNoAPIKey = errors.New("no apikey")
+)
+
 // Error contains an error response from the server.
 type Error struct {
 	// Code is the HTTP response status code and will always be populated.
@@ -19,6 +33,9 @@ type Error struct {
 	Body string
 	// Header contains the response header fields from the server.
 	Header http.Header
+
+	// err is ErrRateLimited, ErrInvalidAPICall or ErrInformation from the json envelope
+	err error
 }
 
 func (e *Error) Error() string {
@@ -34,9 +51,29 @@ func (e *Error) Error() string {
 	return buf.String()
 }
 
+// Unwrap returns ErrRateLimited, ErrInvalidAPICall or ErrInformation
+// if the server replied with the corresponding json envelope
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
 type errorReply struct {
-	Message string `json:"Error Message,omitempty"`
-	Note    string `json:"Note,omitempty"`
+	Message     string `json:"Error Message,omitempty"`
+	Note        string `json:"Note,omitempty"`
+	Information string `json:"Information,omitempty"`
+}
+
+// err returns the error kind of the reply, nil if it is not an error
+func (e *errorReply) err() error {
+	switch {
+	case e.Note != "":
+		return ErrRateLimited
+	case e.Message != "":
+		return ErrInvalidAPICall
+	case e.Information != "":
+		return ErrInformation
+	}
+	return nil
 }
 
 func (e *errorReply) String() string {
@@ -47,5 +84,8 @@ func (e *errorReply) String() string {
 	if e.Note != "" {
 		s = append(s, fmt.Sprintf("Note: %s", e.Note))
 	}
+	if e.Information != "" {
+		s = append(s, fmt.Sprintf("Information: %s", e.Information))
+	}
 	return strings.Join(s, " ")
 }
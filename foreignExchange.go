@@ -1,17 +1,253 @@
 package alphavantage
 
-// To do
-// https://www.alphavantage.co/documentation/#symbolsearch
+import (
+	"net/url"
+	"time"
+)
 
-// NewForeignExchangeService https://www.alphavantage.co/documentation/#symbolsearch
+// NewForeignExchangeService https://www.alphavantage.co/documentation/#fx
 // APIs under this section provide a wide range of data feed for realtime and historical forex (FX) rates.
 func NewForeignExchangeService(s *Service) *ForeignExchangeService {
 	rs := &ForeignExchangeService{s: s}
 	return rs
 }
 
-// ForeignExchangeService https://www.alphavantage.co/documentation/#symbolsearch
+// ForeignExchangeService https://www.alphavantage.co/documentation/#fx
 // APIs under this section provide a wide range of data feed for realtime and historical forex (FX) rates.
 type ForeignExchangeService struct {
 	s *Service
 }
+
+// ExchangeRate https://www.alphavantage.co/documentation/#currency-exchange
+// This API returns the realtime exchange rate for any pair of digital currency (e.g., Bitcoin) or physical currency (e.g., USD).
+// datatype fixed to json
+func (r *ForeignExchangeService) ExchangeRate(from, to string) *ForeignExchangeRateCall {
+	c := &ForeignExchangeRateCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		from: from,
+		to:   to,
+	}
+	c.urlParams.Set("function", "CURRENCY_EXCHANGE_RATE")
+	c.urlParams.Set("from_currency", from)
+	c.urlParams.Set("to_currency", to)
+	return c
+}
+
+// ForeignExchangeRateCall https://www.alphavantage.co/documentation/#currency-exchange
+// This API returns the realtime exchange rate for any pair of digital currency (e.g., Bitcoin) or physical currency (e.g., USD).
+// datatype fixed to json
+type ForeignExchangeRateCall struct {
+	DefaultCall
+
+	from string
+	to   string
+}
+
+// Do send request
+func (c *ForeignExchangeRateCall) Do() (*ExchangeRate, error) {
+	target := new(exchangeRateReply)
+	sr, err := c.doJSONFound(target, c.from+"/"+c.to, func() bool { return target.ExchangeRate != nil })
+	if err != nil {
+		return nil, err
+	}
+
+	ret := target.ExchangeRate
+	ret.ServerResponse = sr
+
+	return ret, nil
+}
+
+// Intraday https://www.alphavantage.co/documentation/#fx-intraday
+// This API returns intraday time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
+// datatype fixed to csv
+func (r *ForeignExchangeService) Intraday(from, to, interval string) *ForeignExchangeIntradayCall {
+	c := &ForeignExchangeIntradayCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", "FX_INTRADAY")
+	c.urlParams.Set("from_symbol", from)
+	c.urlParams.Set("to_symbol", to)
+	c.urlParams.Set("interval", interval)
+	c.urlParams.Set("datatype", "csv")
+	return c
+}
+
+// ForeignExchangeIntradayCall https://www.alphavantage.co/documentation/#fx-intraday
+// This API returns intraday time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
+// datatype fixed to csv
+type ForeignExchangeIntradayCall struct {
+	DefaultCall
+}
+
+// Outputsize By default, outputsize=compact.
+// Strings compact and full are accepted with the following specifications:
+// compact returns only the latest 100 data points;
+// full returns the full-length time series.
+// The "compact" option is recommended if you would like to reduce the data size of each API call.
+func (c *ForeignExchangeIntradayCall) Outputsize(outputsize string) *ForeignExchangeIntradayCall {
+	c.urlParams.Set("outputsize", outputsize)
+
+	return c
+}
+
+// Do send request
+func (c *ForeignExchangeIntradayCall) Do() (*FXSeriesList, error) {
+	// FX_* timestamps are in UTC
+	target := new([]*FXSeries)
+	sr, err := c.doCSVIn(target, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &FXSeriesList{
+		ServerResponse: sr,
+		FXSeries:       *target,
+	}
+
+	return ret, nil
+}
+
+// Daily https://www.alphavantage.co/documentation/#fx-daily
+// This API returns the daily time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
+// datatype fixed to csv
+func (r *ForeignExchangeService) Daily(from, to string) *ForeignExchangeDailyCall {
+	c := &ForeignExchangeDailyCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", "FX_DAILY")
+	c.urlParams.Set("from_symbol", from)
+	c.urlParams.Set("to_symbol", to)
+	c.urlParams.Set("datatype", "csv")
+	return c
+}
+
+// ForeignExchangeDailyCall https://www.alphavantage.co/documentation/#fx-daily
+// This API returns the daily time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
+// datatype fixed to csv
+type ForeignExchangeDailyCall struct {
+	DefaultCall
+}
+
+// Outputsize By default, outputsize=compact.
+// Strings compact and full are accepted with the following specifications:
+// compact returns only the latest 100 data points;
+// full returns the full-length time series.
+// The "compact" option is recommended if you would like to reduce the data size of each API call.
+func (c *ForeignExchangeDailyCall) Outputsize(outputsize string) *ForeignExchangeDailyCall {
+	c.urlParams.Set("outputsize", outputsize)
+
+	return c
+}
+
+// Do send request
+func (c *ForeignExchangeDailyCall) Do() (*FXSeriesList, error) {
+	// FX_* timestamps are in UTC
+	target := new([]*FXSeries)
+	sr, err := c.doCSVIn(target, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &FXSeriesList{
+		ServerResponse: sr,
+		FXSeries:       *target,
+	}
+
+	return ret, nil
+}
+
+// Weekly https://www.alphavantage.co/documentation/#fx-weekly
+// This API returns the weekly time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
+// The latest data point is the price information for the week (or partial week) containing the current trading day, updated realtime.
+// datatype fixed to csv
+func (r *ForeignExchangeService) Weekly(from, to string) *ForeignExchangeWeeklyCall {
+	c := &ForeignExchangeWeeklyCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", "FX_WEEKLY")
+	c.urlParams.Set("from_symbol", from)
+	c.urlParams.Set("to_symbol", to)
+	c.urlParams.Set("datatype", "csv")
+	return c
+}
+
+// ForeignExchangeWeeklyCall https://www.alphavantage.co/documentation/#fx-weekly
+// This API returns the weekly time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
+// The latest data point is the price information for the week (or partial week) containing the current trading day, updated realtime.
+// datatype fixed to csv
+type ForeignExchangeWeeklyCall struct {
+	DefaultCall
+}
+
+// Do send request
+func (c *ForeignExchangeWeeklyCall) Do() (*FXSeriesList, error) {
+	// FX_* timestamps are in UTC
+	target := new([]*FXSeries)
+	sr, err := c.doCSVIn(target, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &FXSeriesList{
+		ServerResponse: sr,
+		FXSeries:       *target,
+	}
+
+	return ret, nil
+}
+
+// Monthly https://www.alphavantage.co/documentation/#fx-monthly
+// This API returns the monthly time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
+// The latest data point is the prices information for the month (or partial month) containing the current trading day, updated realtime.
+// datatype fixed to csv
+func (r *ForeignExchangeService) Monthly(from, to string) *ForeignExchangeMonthlyCall {
+	c := &ForeignExchangeMonthlyCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", "FX_MONTHLY")
+	c.urlParams.Set("from_symbol", from)
+	c.urlParams.Set("to_symbol", to)
+	c.urlParams.Set("datatype", "csv")
+	return c
+}
+
+// ForeignExchangeMonthlyCall https://www.alphavantage.co/documentation/#fx-monthly
+// This API returns the monthly time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
+// The latest data point is the prices information for the month (or partial month) containing the current trading day, updated realtime.
+// datatype fixed to csv
+type ForeignExchangeMonthlyCall struct {
+	DefaultCall
+}
+
+// Do send request
+func (c *ForeignExchangeMonthlyCall) Do() (*FXSeriesList, error) {
+	// FX_* timestamps are in UTC
+	target := new([]*FXSeries)
+	sr, err := c.doCSVIn(target, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &FXSeriesList{
+		ServerResponse: sr,
+		FXSeries:       *target,
+	}
+
+	return ret, nil
+}
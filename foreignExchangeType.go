@@ -0,0 +1,66 @@
+package alphavantage
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// ExchangeRate realtime exchange rate of a currency pair
+type ExchangeRate struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+
+	FromCurrencyCode string  `json:"1. From_Currency Code"`
+	FromCurrencyName string  `json:"2. From_Currency Name"`
+	ToCurrencyCode   string  `json:"3. To_Currency Code"`
+	ToCurrencyName   string  `json:"4. To_Currency Name"`
+	ExchangeRate     float64 `json:"5. Exchange Rate,string"`
+	LastRefreshed    Time    `json:"6. Last Refreshed"`
+	TimeZone         string  `json:"7. Time Zone"`
+	BidPrice         float64 `json:"8. Bid Price,string"`
+	AskPrice         float64 `json:"9. Ask Price,string"`
+}
+
+// UnmarshalJSON parses LastRefreshed in TimeZone
+func (r *ExchangeRate) UnmarshalJSON(data []byte) error {
+	type exchangeRate ExchangeRate
+	v := struct {
+		*exchangeRate
+		LastRefreshed string `json:"6. Last Refreshed"`
+	}{exchangeRate: (*exchangeRate)(r)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	t, err := timeInZone(v.LastRefreshed, r.TimeZone)
+	if err != nil {
+		return errors.Wrapf(err, "timeInZone")
+	}
+	r.LastRefreshed = t
+
+	return nil
+}
+
+type exchangeRateReply struct {
+	ExchangeRate *ExchangeRate `json:"Realtime Currency Exchange Rate"`
+}
+
+// FXSeries FX time series
+type FXSeries struct {
+	Time  Time    `csv:"timestamp"`
+	Open  float64 `csv:"open"`
+	High  float64 `csv:"high"`
+	Low   float64 `csv:"low"`
+	Close float64 `csv:"close"`
+}
+
+// FXSeriesList FXSeries List
+type FXSeriesList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	FXSeries []*FXSeries
+}
@@ -0,0 +1,334 @@
+package alphavantage
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestForeignExchangeRateCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ForeignExchangeRateCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"physical", NewForeignExchangeService(avTest).ExchangeRate("USD", "JPY"), "https://www.alphavantage.co/query?apikey=key&from_currency=USD&function=CURRENCY_EXCHANGE_RATE&to_currency=JPY", false},
+		{"digital", NewForeignExchangeService(avTest).ExchangeRate("BTC", "CNY"), "https://www.alphavantage.co/query?apikey=key&from_currency=BTC&function=CURRENCY_EXCHANGE_RATE&to_currency=CNY", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForeignExchangeRateCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForeignExchangeRateCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForeignExchangeRateCall_Do(t *testing.T) {
+	client := clientTest("key", `{
+    "Realtime Currency Exchange Rate": {
+        "1. From_Currency Code": "USD",
+        "2. From_Currency Name": "United States Dollar",
+        "3. To_Currency Code": "JPY",
+        "4. To_Currency Name": "Japanese Yen",
+        "5. Exchange Rate": "107.67000000",
+        "6. Last Refreshed": "2020-03-08 02:39:01",
+        "7. Time Zone": "UTC",
+        "8. Bid Price": "107.66000000",
+        "9. Ask Price": "107.68000000"
+    }
+}`, http.StatusOK)
+	avTest, _ := New(client)
+
+	clientErr := clientTest("key", `{
+    "Error Message": "Invalid API call. Please retry or visit the documentation (https://www.alphavantage.co/documentation/) for CURRENCY_EXCHANGE_RATE."
+}`, http.StatusOK)
+	avErr, _ := New(clientErr)
+
+	tests := []struct {
+		name    string
+		c       *ForeignExchangeRateCall
+		want    *ExchangeRate
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewForeignExchangeService(avTest).ExchangeRate("USD", "JPY"), &ExchangeRate{
+			ServerResponse:   ServerResponse{HTTPStatusCode: 200, Header: http.Header{}},
+			FromCurrencyCode: "USD",
+			FromCurrencyName: "United States Dollar",
+			ToCurrencyCode:   "JPY",
+			ToCurrencyName:   "Japanese Yen",
+			ExchangeRate:     107.67,
+			LastRefreshed:    Time(time.Date(2020, time.March, 8, 2, 39, 1, 0, time.UTC)), // in the gap of the US/Eastern spring forward
+			TimeZone:         "UTC",
+			BidPrice:         107.66,
+			AskPrice:         107.68,
+		}, false},
+		{"Error", NewForeignExchangeService(avErr).ExchangeRate("USD", "XXX"), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForeignExchangeRateCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForeignExchangeRateCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForeignExchangeIntradayCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ForeignExchangeIntradayCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"5min", NewForeignExchangeService(avTest).Intraday("EUR", "USD", TimeSeriesIntervalFiveMinute), "https://www.alphavantage.co/query?apikey=key&datatype=csv&from_symbol=EUR&function=FX_INTRADAY&interval=5min&to_symbol=USD", false},
+		{"full", NewForeignExchangeService(avTest).Intraday("EUR", "USD", TimeSeriesIntervalFiveMinute).Outputsize(OutputSizeFull), "https://www.alphavantage.co/query?apikey=key&datatype=csv&from_symbol=EUR&function=FX_INTRADAY&interval=5min&outputsize=full&to_symbol=USD", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForeignExchangeIntradayCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForeignExchangeIntradayCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForeignExchangeIntradayCall_Do(t *testing.T) {
+	client := clientTest("key", `timestamp,open,high,low,close
+2020-04-02 07:40:00,1.0962,1.0968,1.0960,1.0965
+2020-03-08 02:30:00,1.0962,1.0968,1.0960,1.0965`, http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ForeignExchangeIntradayCall
+		want    []*FXSeries
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewForeignExchangeService(avTest).Intraday("EUR", "USD", TimeSeriesIntervalFiveMinute), []*FXSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 7, 40, 0, 0, time.UTC)), Open: 1.0962, High: 1.0968, Low: 1.096, Close: 1.0965},
+			// in the gap of the US/Eastern spring forward
+			{Time: Time(time.Date(2020, time.March, 8, 2, 30, 0, 0, time.UTC)), Open: 1.0962, High: 1.0968, Low: 1.096, Close: 1.0965},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForeignExchangeIntradayCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.FXSeries
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForeignExchangeIntradayCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForeignExchangeDailyCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ForeignExchangeDailyCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewForeignExchangeService(avTest).Daily("EUR", "USD"), "https://www.alphavantage.co/query?apikey=key&datatype=csv&from_symbol=EUR&function=FX_DAILY&to_symbol=USD", false},
+		{"full", NewForeignExchangeService(avTest).Daily("EUR", "USD").Outputsize(OutputSizeFull), "https://www.alphavantage.co/query?apikey=key&datatype=csv&from_symbol=EUR&function=FX_DAILY&outputsize=full&to_symbol=USD", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForeignExchangeDailyCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForeignExchangeDailyCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForeignExchangeDailyCall_Do(t *testing.T) {
+	client := clientTest("key", `timestamp,open,high,low,close
+2020-04-02,1.0962,1.0968,1.0960,1.0965`, http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ForeignExchangeDailyCall
+		want    []*FXSeries
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewForeignExchangeService(avTest).Daily("EUR", "USD"), []*FXSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)), Open: 1.0962, High: 1.0968, Low: 1.096, Close: 1.0965},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForeignExchangeDailyCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.FXSeries
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForeignExchangeDailyCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForeignExchangeWeeklyCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ForeignExchangeWeeklyCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewForeignExchangeService(avTest).Weekly("EUR", "USD"), "https://www.alphavantage.co/query?apikey=key&datatype=csv&from_symbol=EUR&function=FX_WEEKLY&to_symbol=USD", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForeignExchangeWeeklyCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForeignExchangeWeeklyCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForeignExchangeWeeklyCall_Do(t *testing.T) {
+	client := clientTest("key", `timestamp,open,high,low,close
+2020-04-02,1.0962,1.0968,1.0960,1.0965`, http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ForeignExchangeWeeklyCall
+		want    []*FXSeries
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewForeignExchangeService(avTest).Weekly("EUR", "USD"), []*FXSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)), Open: 1.0962, High: 1.0968, Low: 1.096, Close: 1.0965},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForeignExchangeWeeklyCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.FXSeries
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForeignExchangeWeeklyCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForeignExchangeMonthlyCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ForeignExchangeMonthlyCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewForeignExchangeService(avTest).Monthly("EUR", "USD"), "https://www.alphavantage.co/query?apikey=key&datatype=csv&from_symbol=EUR&function=FX_MONTHLY&to_symbol=USD", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForeignExchangeMonthlyCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForeignExchangeMonthlyCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForeignExchangeMonthlyCall_Do(t *testing.T) {
+	client := clientTest("key", `timestamp,open,high,low,close
+2020-04-02,1.0962,1.0968,1.0960,1.0965`, http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ForeignExchangeMonthlyCall
+		want    []*FXSeries
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewForeignExchangeService(avTest).Monthly("EUR", "USD"), []*FXSeries{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)), Open: 1.0962, High: 1.0968, Low: 1.096, Close: 1.0965},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForeignExchangeMonthlyCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.FXSeries
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForeignExchangeMonthlyCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
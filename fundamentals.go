@@ -0,0 +1,355 @@
+package alphavantage
+
+import (
+	"net/url"
+	"time"
+)
+
+// NewFundamentalsService https://www.alphavantage.co/documentation/#fundamentals
+// APIs under this section cover company information, financial statements and earnings.
+func NewFundamentalsService(s *Service) *FundamentalsService {
+	rs := &FundamentalsService{s: s}
+	return rs
+}
+
+// FundamentalsService https://www.alphavantage.co/documentation/#fundamentals
+// APIs under this section cover company information, financial statements and earnings.
+type FundamentalsService struct {
+	s *Service
+}
+
+// Overview https://www.alphavantage.co/documentation/#company-overview
+// This API returns the company information, financial ratios, and other key metrics for the equity specified.
+// datatype fixed to json
+func (r *FundamentalsService) Overview(symbol string) *FundamentalsOverviewCall {
+	c := &FundamentalsOverviewCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol: symbol,
+	}
+	c.urlParams.Set("function", "OVERVIEW")
+	c.urlParams.Set("symbol", symbol)
+	return c
+}
+
+// FundamentalsOverviewCall https://www.alphavantage.co/documentation/#company-overview
+// This API returns the company information, financial ratios, and other key metrics for the equity specified.
+// datatype fixed to json
+type FundamentalsOverviewCall struct {
+	DefaultCall
+
+	symbol string
+}
+
+// Do send request
+func (c *FundamentalsOverviewCall) Do() (*CompanyOverview, error) {
+	ret := &CompanyOverview{}
+	// an unknown symbol gets an empty object
+	sr, err := c.doJSONFound(ret, c.symbol, func() bool { return ret.Symbol != "" })
+	if err != nil {
+		return nil, err
+	}
+	ret.ServerResponse = sr
+
+	return ret, nil
+}
+
+// IncomeStatement https://www.alphavantage.co/documentation/#income-statement
+// This API returns the annual and quarterly income statements for the company of interest.
+// datatype fixed to json
+func (r *FundamentalsService) IncomeStatement(symbol string) *FundamentalsIncomeStatementCall {
+	c := &FundamentalsIncomeStatementCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol: symbol,
+	}
+	c.urlParams.Set("function", "INCOME_STATEMENT")
+	c.urlParams.Set("symbol", symbol)
+	return c
+}
+
+// FundamentalsIncomeStatementCall https://www.alphavantage.co/documentation/#income-statement
+// This API returns the annual and quarterly income statements for the company of interest.
+// datatype fixed to json
+type FundamentalsIncomeStatementCall struct {
+	DefaultCall
+
+	symbol string
+}
+
+// Do send request
+func (c *FundamentalsIncomeStatementCall) Do() (*IncomeStatementList, error) {
+	ret := &IncomeStatementList{}
+	// an unknown symbol gets an empty object
+	sr, err := c.doJSONFound(ret, c.symbol, func() bool { return ret.Symbol != "" })
+	if err != nil {
+		return nil, err
+	}
+	ret.ServerResponse = sr
+
+	return ret, nil
+}
+
+// BalanceSheet https://www.alphavantage.co/documentation/#balance-sheet
+// This API returns the annual and quarterly balance sheets for the company of interest.
+// datatype fixed to json
+func (r *FundamentalsService) BalanceSheet(symbol string) *FundamentalsBalanceSheetCall {
+	c := &FundamentalsBalanceSheetCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol: symbol,
+	}
+	c.urlParams.Set("function", "BALANCE_SHEET")
+	c.urlParams.Set("symbol", symbol)
+	return c
+}
+
+// FundamentalsBalanceSheetCall https://www.alphavantage.co/documentation/#balance-sheet
+// This API returns the annual and quarterly balance sheets for the company of interest.
+// datatype fixed to json
+type FundamentalsBalanceSheetCall struct {
+	DefaultCall
+
+	symbol string
+}
+
+// Do send request
+func (c *FundamentalsBalanceSheetCall) Do() (*BalanceSheetList, error) {
+	ret := &BalanceSheetList{}
+	// an unknown symbol gets an empty object
+	sr, err := c.doJSONFound(ret, c.symbol, func() bool { return ret.Symbol != "" })
+	if err != nil {
+		return nil, err
+	}
+	ret.ServerResponse = sr
+
+	return ret, nil
+}
+
+// CashFlow https://www.alphavantage.co/documentation/#cash-flow
+// This API returns the annual and quarterly cash flows for the company of interest.
+// datatype fixed to json
+func (r *FundamentalsService) CashFlow(symbol string) *FundamentalsCashFlowCall {
+	c := &FundamentalsCashFlowCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol: symbol,
+	}
+	c.urlParams.Set("function", "CASH_FLOW")
+	c.urlParams.Set("symbol", symbol)
+	return c
+}
+
+// FundamentalsCashFlowCall https://www.alphavantage.co/documentation/#cash-flow
+// This API returns the annual and quarterly cash flows for the company of interest.
+// datatype fixed to json
+type FundamentalsCashFlowCall struct {
+	DefaultCall
+
+	symbol string
+}
+
+// Do send request
+func (c *FundamentalsCashFlowCall) Do() (*CashFlowList, error) {
+	ret := &CashFlowList{}
+	// an unknown symbol gets an empty object
+	sr, err := c.doJSONFound(ret, c.symbol, func() bool { return ret.Symbol != "" })
+	if err != nil {
+		return nil, err
+	}
+	ret.ServerResponse = sr
+
+	return ret, nil
+}
+
+// Earnings https://www.alphavantage.co/documentation/#earnings
+// This API returns the annual and quarterly earnings (EPS) for the company of interest.
+// Quarterly data also includes analyst estimates and surprise metrics.
+// datatype fixed to json
+func (r *FundamentalsService) Earnings(symbol string) *FundamentalsEarningsCall {
+	c := &FundamentalsEarningsCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol: symbol,
+	}
+	c.urlParams.Set("function", "EARNINGS")
+	c.urlParams.Set("symbol", symbol)
+	return c
+}
+
+// FundamentalsEarningsCall https://www.alphavantage.co/documentation/#earnings
+// This API returns the annual and quarterly earnings (EPS) for the company of interest.
+// Quarterly data also includes analyst estimates and surprise metrics.
+// datatype fixed to json
+type FundamentalsEarningsCall struct {
+	DefaultCall
+
+	symbol string
+}
+
+// Do send request
+func (c *FundamentalsEarningsCall) Do() (*EarningsList, error) {
+	ret := &EarningsList{}
+	// an unknown symbol gets an empty object
+	sr, err := c.doJSONFound(ret, c.symbol, func() bool { return ret.Symbol != "" })
+	if err != nil {
+		return nil, err
+	}
+	ret.ServerResponse = sr
+
+	return ret, nil
+}
+
+// EarningsCalendar https://www.alphavantage.co/documentation/#earnings-calendar
+// This API returns a list of company earnings expected in the next 3, 6, or 12 months.
+// horizon is EarningsHorizonThreeMonth, EarningsHorizonSixMonth or EarningsHorizonTwelveMonth.
+// datatype fixed to csv
+func (r *FundamentalsService) EarningsCalendar(horizon string) *FundamentalsEarningsCalendarCall {
+	c := &FundamentalsEarningsCalendarCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", "EARNINGS_CALENDAR")
+	c.urlParams.Set("horizon", horizon)
+	return c
+}
+
+// FundamentalsEarningsCalendarCall https://www.alphavantage.co/documentation/#earnings-calendar
+// This API returns a list of company earnings expected in the next 3, 6, or 12 months.
+// datatype fixed to csv
+type FundamentalsEarningsCalendarCall struct {
+	DefaultCall
+}
+
+// Symbol By default, no symbol will be set for this API.
+// When no symbol is set, the API endpoint will return the full list of company earnings scheduled.
+// If a symbol is set, the API endpoint will return the expected earnings for that specific symbol.
+func (c *FundamentalsEarningsCalendarCall) Symbol(symbol string) *FundamentalsEarningsCalendarCall {
+	c.urlParams.Set("symbol", symbol)
+
+	return c
+}
+
+// Do send request
+func (c *FundamentalsEarningsCalendarCall) Do() (*EarningsCalendarList, error) {
+	target := new([]*EarningsCalendar)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &EarningsCalendarList{
+		ServerResponse:   sr,
+		EarningsCalendar: *target,
+	}
+
+	return ret, nil
+}
+
+// ListingStatus https://www.alphavantage.co/documentation/#listing-status
+// This API returns a list of active or delisted US stocks and ETFs, either as of the latest trading day or at a specific time in history.
+// datatype fixed to csv
+func (r *FundamentalsService) ListingStatus() *FundamentalsListingStatusCall {
+	c := &FundamentalsListingStatusCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", "LISTING_STATUS")
+	return c
+}
+
+// FundamentalsListingStatusCall https://www.alphavantage.co/documentation/#listing-status
+// This API returns a list of active or delisted US stocks and ETFs, either as of the latest trading day or at a specific time in history.
+// datatype fixed to csv
+type FundamentalsListingStatusCall struct {
+	DefaultCall
+}
+
+// Date If no date is set, the API endpoint will return a list of active or delisted symbols as of the latest trading day.
+// If a date is set, the API endpoint will "travel back" in time and return a list of active or delisted symbols on that particular date in history.
+// Any date later than 2010-01-01 is supported.
+func (c *FundamentalsListingStatusCall) Date(date time.Time) *FundamentalsListingStatusCall {
+	c.urlParams.Set("date", date.Format("2006-01-02"))
+
+	return c
+}
+
+// State By default, state=active and the API will return a list of actively traded stocks and ETFs.
+// Set state=delisted to query a list of delisted assets.
+func (c *FundamentalsListingStatusCall) State(state string) *FundamentalsListingStatusCall {
+	c.urlParams.Set("state", state)
+
+	return c
+}
+
+// Do send request
+func (c *FundamentalsListingStatusCall) Do() (*ListingStatusList, error) {
+	target := new([]*ListingStatus)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &ListingStatusList{
+		ServerResponse: sr,
+		ListingStatus:  *target,
+	}
+
+	return ret, nil
+}
+
+// IPOCalendar https://www.alphavantage.co/documentation/#ipo-calendar
+// This API returns a list of IPOs expected in the next 3 months.
+// datatype fixed to csv
+func (r *FundamentalsService) IPOCalendar() *FundamentalsIPOCalendarCall {
+	c := &FundamentalsIPOCalendarCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", "IPO_CALENDAR")
+	return c
+}
+
+// FundamentalsIPOCalendarCall https://www.alphavantage.co/documentation/#ipo-calendar
+// This API returns a list of IPOs expected in the next 3 months.
+// datatype fixed to csv
+type FundamentalsIPOCalendarCall struct {
+	DefaultCall
+}
+
+// Do send request
+func (c *FundamentalsIPOCalendarCall) Do() (*IPOCalendarList, error) {
+	target := new([]*IPOCalendar)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &IPOCalendarList{
+		ServerResponse: sr,
+		IPOCalendar:    *target,
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,389 @@
+package alphavantage
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// NullFloat a number of fundamentals data, which may be missing
+type NullFloat struct {
+	Float64 float64
+	// Valid is false if the value is "None", "-", ".", empty or null
+	Valid bool
+}
+
+// isNull reports whether s is a missing value of fundamentals data
+func isNull(s string) bool {
+	switch s {
+	case "None", "-", ".", "", "null":
+		return true
+	}
+	return false
+}
+
+// unquote returns the string of a json value, which is left as it is if not quoted
+func unquote(data []byte) []byte {
+	if s, err := strconv.Unquote(string(data)); err == nil {
+		return []byte(s)
+	}
+	return data
+}
+
+// UnmarshalCSV parse a number
+func (n *NullFloat) UnmarshalCSV(data []byte) error {
+	if isNull(string(data)) {
+		*n = NullFloat{}
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return errors.Wrapf(err, "strconv.ParseFloat")
+	}
+	*n = NullFloat{Float64: f, Valid: true}
+
+	return nil
+}
+
+// UnmarshalJSON parse a number or a string of number
+func (n *NullFloat) UnmarshalJSON(data []byte) error {
+	return n.UnmarshalCSV(unquote(data))
+}
+
+// NullPercent a percent of fundamentals data, which may be missing
+type NullPercent struct {
+	Percent Percent
+	// Valid is false if the value is "None", "-", ".", empty or null
+	Valid bool
+}
+
+// UnmarshalCSV parse a percent
+func (n *NullPercent) UnmarshalCSV(data []byte) error {
+	if isNull(string(data)) {
+		*n = NullPercent{}
+		return nil
+	}
+
+	if err := n.Percent.UnmarshalCSV(data); err != nil {
+		return errors.Wrapf(err, "Percent.UnmarshalCSV")
+	}
+	n.Valid = true
+
+	return nil
+}
+
+// UnmarshalJSON parse a number or a string of percent
+func (n *NullPercent) UnmarshalJSON(data []byte) error {
+	return n.UnmarshalCSV(unquote(data))
+}
+
+// NullTime a date of fundamentals data, which may be missing
+type NullTime struct {
+	Time Time
+	// Valid is false if the value is "None", "-", ".", empty or null
+	Valid bool
+}
+
+// UnmarshalCSV parse a date
+func (n *NullTime) UnmarshalCSV(data []byte) error {
+	if isNull(string(data)) {
+		*n = NullTime{}
+		return nil
+	}
+
+	if err := n.Time.UnmarshalCSV(data); err != nil {
+		return errors.Wrapf(err, "Time.UnmarshalCSV")
+	}
+	n.Valid = true
+
+	return nil
+}
+
+// UnmarshalJSON parse a string of date
+func (n *NullTime) UnmarshalJSON(data []byte) error {
+	return n.UnmarshalCSV(unquote(data))
+}
+
+// CompanyOverview company information, financial ratios, and other key metrics
+type CompanyOverview struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+
+	Symbol        string   `json:"Symbol"`
+	AssetType     string   `json:"AssetType"`
+	Name          string   `json:"Name"`
+	Description   string   `json:"Description"`
+	CIK           string   `json:"CIK"`
+	Exchange      string   `json:"Exchange"`
+	Currency      string   `json:"Currency"`
+	Country       string   `json:"Country"`
+	Sector        string   `json:"Sector"`
+	Industry      string   `json:"Industry"`
+	Address       string   `json:"Address"`
+	FiscalYearEnd string   `json:"FiscalYearEnd"`
+	LatestQuarter NullTime `json:"LatestQuarter"`
+
+	MarketCapitalization       NullFloat `json:"MarketCapitalization"`
+	EBITDA                     NullFloat `json:"EBITDA"`
+	PERatio                    NullFloat `json:"PERatio"`
+	PEGRatio                   NullFloat `json:"PEGRatio"`
+	BookValue                  NullFloat `json:"BookValue"`
+	DividendPerShare           NullFloat `json:"DividendPerShare"`
+	DividendYield              NullFloat `json:"DividendYield"`
+	EPS                        NullFloat `json:"EPS"`
+	RevenuePerShareTTM         NullFloat `json:"RevenuePerShareTTM"`
+	ProfitMargin               NullFloat `json:"ProfitMargin"`
+	OperatingMarginTTM         NullFloat `json:"OperatingMarginTTM"`
+	ReturnOnAssetsTTM          NullFloat `json:"ReturnOnAssetsTTM"`
+	ReturnOnEquityTTM          NullFloat `json:"ReturnOnEquityTTM"`
+	RevenueTTM                 NullFloat `json:"RevenueTTM"`
+	GrossProfitTTM             NullFloat `json:"GrossProfitTTM"`
+	DilutedEPSTTM              NullFloat `json:"DilutedEPSTTM"`
+	QuarterlyEarningsGrowthYOY NullFloat `json:"QuarterlyEarningsGrowthYOY"`
+	QuarterlyRevenueGrowthYOY  NullFloat `json:"QuarterlyRevenueGrowthYOY"`
+	AnalystTargetPrice         NullFloat `json:"AnalystTargetPrice"`
+	TrailingPE                 NullFloat `json:"TrailingPE"`
+	ForwardPE                  NullFloat `json:"ForwardPE"`
+	PriceToSalesRatioTTM       NullFloat `json:"PriceToSalesRatioTTM"`
+	PriceToBookRatio           NullFloat `json:"PriceToBookRatio"`
+	EVToRevenue                NullFloat `json:"EVToRevenue"`
+	EVToEBITDA                 NullFloat `json:"EVToEBITDA"`
+	Beta                       NullFloat `json:"Beta"`
+	FiftyTwoWeekHigh           NullFloat `json:"52WeekHigh"`
+	FiftyTwoWeekLow            NullFloat `json:"52WeekLow"`
+	FiftyDayMovingAverage      NullFloat `json:"50DayMovingAverage"`
+	TwoHundredDayMovingAverage NullFloat `json:"200DayMovingAverage"`
+	SharesOutstanding          NullFloat `json:"SharesOutstanding"`
+
+	DividendDate   NullTime `json:"DividendDate"`
+	ExDividendDate NullTime `json:"ExDividendDate"`
+}
+
+// IncomeStatement income statement of a fiscal period, a line item is not Valid if it is "None"
+type IncomeStatement struct {
+	FiscalDateEnding Time   `json:"fiscalDateEnding"`
+	ReportedCurrency string `json:"reportedCurrency"`
+
+	GrossProfit                       NullFloat `json:"grossProfit"`
+	TotalRevenue                      NullFloat `json:"totalRevenue"`
+	CostOfRevenue                     NullFloat `json:"costOfRevenue"`
+	CostofGoodsAndServicesSold        NullFloat `json:"costofGoodsAndServicesSold"`
+	OperatingIncome                   NullFloat `json:"operatingIncome"`
+	SellingGeneralAndAdministrative   NullFloat `json:"sellingGeneralAndAdministrative"`
+	ResearchAndDevelopment            NullFloat `json:"researchAndDevelopment"`
+	OperatingExpenses                 NullFloat `json:"operatingExpenses"`
+	InvestmentIncomeNet               NullFloat `json:"investmentIncomeNet"`
+	NetInterestIncome                 NullFloat `json:"netInterestIncome"`
+	InterestIncome                    NullFloat `json:"interestIncome"`
+	InterestExpense                   NullFloat `json:"interestExpense"`
+	NonInterestIncome                 NullFloat `json:"nonInterestIncome"`
+	OtherNonOperatingIncome           NullFloat `json:"otherNonOperatingIncome"`
+	Depreciation                      NullFloat `json:"depreciation"`
+	DepreciationAndAmortization       NullFloat `json:"depreciationAndAmortization"`
+	IncomeBeforeTax                   NullFloat `json:"incomeBeforeTax"`
+	IncomeTaxExpense                  NullFloat `json:"incomeTaxExpense"`
+	InterestAndDebtExpense            NullFloat `json:"interestAndDebtExpense"`
+	NetIncomeFromContinuingOperations NullFloat `json:"netIncomeFromContinuingOperations"`
+	ComprehensiveIncomeNetOfTax       NullFloat `json:"comprehensiveIncomeNetOfTax"`
+	EBIT                              NullFloat `json:"ebit"`
+	EBITDA                            NullFloat `json:"ebitda"`
+	NetIncome                         NullFloat `json:"netIncome"`
+}
+
+// IncomeStatementList annual and quarterly income statements of a company
+type IncomeStatementList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+
+	Symbol           string             `json:"symbol"`
+	AnnualReports    []*IncomeStatement `json:"annualReports"`
+	QuarterlyReports []*IncomeStatement `json:"quarterlyReports"`
+}
+
+// BalanceSheet balance sheet of a fiscal period, a line item is not Valid if it is "None"
+type BalanceSheet struct {
+	FiscalDateEnding Time   `json:"fiscalDateEnding"`
+	ReportedCurrency string `json:"reportedCurrency"`
+
+	TotalAssets                            NullFloat `json:"totalAssets"`
+	TotalCurrentAssets                     NullFloat `json:"totalCurrentAssets"`
+	CashAndCashEquivalentsAtCarryingValue  NullFloat `json:"cashAndCashEquivalentsAtCarryingValue"`
+	CashAndShortTermInvestments            NullFloat `json:"cashAndShortTermInvestments"`
+	Inventory                              NullFloat `json:"inventory"`
+	CurrentNetReceivables                  NullFloat `json:"currentNetReceivables"`
+	TotalNonCurrentAssets                  NullFloat `json:"totalNonCurrentAssets"`
+	PropertyPlantEquipment                 NullFloat `json:"propertyPlantEquipment"`
+	AccumulatedDepreciationAmortizationPPE NullFloat `json:"accumulatedDepreciationAmortizationPPE"`
+	IntangibleAssets                       NullFloat `json:"intangibleAssets"`
+	IntangibleAssetsExcludingGoodwill      NullFloat `json:"intangibleAssetsExcludingGoodwill"`
+	Goodwill                               NullFloat `json:"goodwill"`
+	Investments                            NullFloat `json:"investments"`
+	LongTermInvestments                    NullFloat `json:"longTermInvestments"`
+	ShortTermInvestments                   NullFloat `json:"shortTermInvestments"`
+	OtherCurrentAssets                     NullFloat `json:"otherCurrentAssets"`
+	OtherNonCurrentAssets                  NullFloat `json:"otherNonCurrentAssets"`
+	TotalLiabilities                       NullFloat `json:"totalLiabilities"`
+	TotalCurrentLiabilities                NullFloat `json:"totalCurrentLiabilities"`
+	CurrentAccountsPayable                 NullFloat `json:"currentAccountsPayable"`
+	DeferredRevenue                        NullFloat `json:"deferredRevenue"`
+	CurrentDebt                            NullFloat `json:"currentDebt"`
+	ShortTermDebt                          NullFloat `json:"shortTermDebt"`
+	TotalNonCurrentLiabilities             NullFloat `json:"totalNonCurrentLiabilities"`
+	CapitalLeaseObligations                NullFloat `json:"capitalLeaseObligations"`
+	LongTermDebt                           NullFloat `json:"longTermDebt"`
+	CurrentLongTermDebt                    NullFloat `json:"currentLongTermDebt"`
+	LongTermDebtNoncurrent                 NullFloat `json:"longTermDebtNoncurrent"`
+	ShortLongTermDebtTotal                 NullFloat `json:"shortLongTermDebtTotal"`
+	OtherCurrentLiabilities                NullFloat `json:"otherCurrentLiabilities"`
+	OtherNonCurrentLiabilities             NullFloat `json:"otherNonCurrentLiabilities"`
+	TotalShareholderEquity                 NullFloat `json:"totalShareholderEquity"`
+	TreasuryStock                          NullFloat `json:"treasuryStock"`
+	RetainedEarnings                       NullFloat `json:"retainedEarnings"`
+	CommonStock                            NullFloat `json:"commonStock"`
+	CommonStockSharesOutstanding           NullFloat `json:"commonStockSharesOutstanding"`
+}
+
+// BalanceSheetList annual and quarterly balance sheets of a company
+type BalanceSheetList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+
+	Symbol           string          `json:"symbol"`
+	AnnualReports    []*BalanceSheet `json:"annualReports"`
+	QuarterlyReports []*BalanceSheet `json:"quarterlyReports"`
+}
+
+// CashFlow cash flow of a fiscal period, a line item is not Valid if it is "None"
+type CashFlow struct {
+	FiscalDateEnding Time   `json:"fiscalDateEnding"`
+	ReportedCurrency string `json:"reportedCurrency"`
+
+	OperatingCashflow                                         NullFloat `json:"operatingCashflow"`
+	PaymentsForOperatingActivities                            NullFloat `json:"paymentsForOperatingActivities"`
+	ProceedsFromOperatingActivities                           NullFloat `json:"proceedsFromOperatingActivities"`
+	ChangeInOperatingLiabilities                              NullFloat `json:"changeInOperatingLiabilities"`
+	ChangeInOperatingAssets                                   NullFloat `json:"changeInOperatingAssets"`
+	DepreciationDepletionAndAmortization                      NullFloat `json:"depreciationDepletionAndAmortization"`
+	CapitalExpenditures                                       NullFloat `json:"capitalExpenditures"`
+	ChangeInReceivables                                       NullFloat `json:"changeInReceivables"`
+	ChangeInInventory                                         NullFloat `json:"changeInInventory"`
+	ProfitLoss                                                NullFloat `json:"profitLoss"`
+	CashflowFromInvestment                                    NullFloat `json:"cashflowFromInvestment"`
+	CashflowFromFinancing                                     NullFloat `json:"cashflowFromFinancing"`
+	ProceedsFromRepaymentsOfShortTermDebt                     NullFloat `json:"proceedsFromRepaymentsOfShortTermDebt"`
+	PaymentsForRepurchaseOfCommonStock                        NullFloat `json:"paymentsForRepurchaseOfCommonStock"`
+	PaymentsForRepurchaseOfEquity                             NullFloat `json:"paymentsForRepurchaseOfEquity"`
+	PaymentsForRepurchaseOfPreferredStock                     NullFloat `json:"paymentsForRepurchaseOfPreferredStock"`
+	DividendPayout                                            NullFloat `json:"dividendPayout"`
+	DividendPayoutCommonStock                                 NullFloat `json:"dividendPayoutCommonStock"`
+	DividendPayoutPreferredStock                              NullFloat `json:"dividendPayoutPreferredStock"`
+	ProceedsFromIssuanceOfCommonStock                         NullFloat `json:"proceedsFromIssuanceOfCommonStock"`
+	ProceedsFromIssuanceOfLongTermDebtAndCapitalSecuritiesNet NullFloat `json:"proceedsFromIssuanceOfLongTermDebtAndCapitalSecuritiesNet"`
+	ProceedsFromIssuanceOfPreferredStock                      NullFloat `json:"proceedsFromIssuanceOfPreferredStock"`
+	ProceedsFromRepurchaseOfEquity                            NullFloat `json:"proceedsFromRepurchaseOfEquity"`
+	ProceedsFromSaleOfTreasuryStock                           NullFloat `json:"proceedsFromSaleOfTreasuryStock"`
+	ChangeInCashAndCashEquivalents                            NullFloat `json:"changeInCashAndCashEquivalents"`
+	ChangeInExchangeRate                                      NullFloat `json:"changeInExchangeRate"`
+	NetIncome                                                 NullFloat `json:"netIncome"`
+}
+
+// CashFlowList annual and quarterly cash flows of a company
+type CashFlowList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+
+	Symbol           string      `json:"symbol"`
+	AnnualReports    []*CashFlow `json:"annualReports"`
+	QuarterlyReports []*CashFlow `json:"quarterlyReports"`
+}
+
+// AnnualEarning earnings per share of a fiscal year
+type AnnualEarning struct {
+	FiscalDateEnding Time      `json:"fiscalDateEnding"`
+	ReportedEPS      NullFloat `json:"reportedEPS"`
+}
+
+// QuarterlyEarning reported and estimated earnings per share of a fiscal quarter
+type QuarterlyEarning struct {
+	FiscalDateEnding   Time        `json:"fiscalDateEnding"`
+	ReportedDate       NullTime    `json:"reportedDate"`
+	ReportedEPS        NullFloat   `json:"reportedEPS"`
+	EstimatedEPS       NullFloat   `json:"estimatedEPS"`
+	Surprise           NullFloat   `json:"surprise"`
+	SurprisePercentage NullPercent `json:"surprisePercentage"`
+}
+
+// EarningsList annual and quarterly earnings of a company
+type EarningsList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+
+	Symbol            string              `json:"symbol"`
+	AnnualEarnings    []*AnnualEarning    `json:"annualEarnings"`
+	QuarterlyEarnings []*QuarterlyEarning `json:"quarterlyEarnings"`
+}
+
+// EarningsCalendar upcoming earnings report of a company
+type EarningsCalendar struct {
+	Symbol           string    `csv:"symbol"`
+	Name             string    `csv:"name"`
+	ReportDate       Time      `csv:"reportDate"`
+	FiscalDateEnding Time      `csv:"fiscalDateEnding"`
+	Estimate         NullFloat `csv:"estimate"`
+	Currency         string    `csv:"currency"`
+}
+
+// EarningsCalendarList EarningsCalendar List
+type EarningsCalendarList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	EarningsCalendar []*EarningsCalendar
+}
+
+// ListingStatus listing of a US stock or ETF
+type ListingStatus struct {
+	Symbol        string   `csv:"symbol"`
+	Name          string   `csv:"name"`
+	Exchange      string   `csv:"exchange"`
+	AssetType     string   `csv:"assetType"`
+	IPODate       Time     `csv:"ipoDate"`
+	DelistingDate NullTime `csv:"delistingDate"`
+	Status        string   `csv:"status"`
+}
+
+// ListingStatusList ListingStatus List
+type ListingStatusList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	ListingStatus []*ListingStatus
+}
+
+// IPOCalendar upcoming IPO
+type IPOCalendar struct {
+	Symbol         string    `csv:"symbol"`
+	Name           string    `csv:"name"`
+	IPODate        Time      `csv:"ipoDate"`
+	PriceRangeLow  NullFloat `csv:"priceRangeLow"`
+	PriceRangeHigh NullFloat `csv:"priceRangeHigh"`
+	Currency       string    `csv:"currency"`
+	Exchange       string    `csv:"exchange"`
+}
+
+// IPOCalendarList IPOCalendar List
+type IPOCalendarList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	IPOCalendar []*IPOCalendar
+}
@@ -0,0 +1,705 @@
+package alphavantage
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFundamentalsOverviewCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsOverviewCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewFundamentalsService(avTest).Overview("IBM"), "https://www.alphavantage.co/query?apikey=key&function=OVERVIEW&symbol=IBM", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsOverviewCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsOverviewCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsOverviewCall_Do(t *testing.T) {
+	client := clientTest("key", `{
+    "Symbol": "IBM",
+    "AssetType": "Common Stock",
+    "Name": "International Business Machines Corporation",
+    "Exchange": "NYSE",
+    "Currency": "USD",
+    "Country": "USA",
+    "Sector": "Technology",
+    "Industry": "Information Technology Services",
+    "FiscalYearEnd": "December",
+    "LatestQuarter": "2020-09-30",
+    "MarketCapitalization": "114234786000",
+    "PERatio": "14.5211",
+    "PEGRatio": "9.1338",
+    "EPS": "8.811",
+    "DividendYield": "0.0509",
+    "Beta": "1.2302",
+    "52WeekHigh": "158.75",
+    "52WeekLow": "90.56",
+    "SharesOutstanding": "891057000",
+    "ForwardPE": "None",
+    "DividendDate": "2020-12-10",
+    "ExDividendDate": "None"
+}`, http.StatusOK)
+	avTest, _ := New(client)
+
+	avEmpty, _ := New(clientTest("key", "{}", http.StatusOK))
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsOverviewCall
+		want    *CompanyOverview
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewFundamentalsService(avTest).Overview("IBM"), &CompanyOverview{
+			ServerResponse:       ServerResponse{HTTPStatusCode: http.StatusOK, Header: http.Header{}},
+			Symbol:               "IBM",
+			AssetType:            "Common Stock",
+			Name:                 "International Business Machines Corporation",
+			Exchange:             "NYSE",
+			Currency:             "USD",
+			Country:              "USA",
+			Sector:               "Technology",
+			Industry:             "Information Technology Services",
+			FiscalYearEnd:        "December",
+			LatestQuarter:        NullTime{Time(time.Date(2020, time.September, 30, 0, 0, 0, 0, eastern)), true},
+			MarketCapitalization: NullFloat{114234786000, true},
+			PERatio:              NullFloat{14.5211, true},
+			PEGRatio:             NullFloat{9.1338, true},
+			EPS:                  NullFloat{8.811, true},
+			DividendYield:        NullFloat{0.0509, true},
+			Beta:                 NullFloat{1.2302, true},
+			FiftyTwoWeekHigh:     NullFloat{158.75, true},
+			FiftyTwoWeekLow:      NullFloat{90.56, true},
+			SharesOutstanding:    NullFloat{891057000, true},
+			DividendDate:         NullTime{Time(time.Date(2020, time.December, 10, 0, 0, 0, 0, eastern)), true},
+		}, false},
+		{"Not Found", NewFundamentalsService(avEmpty).Overview("XXX"), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsOverviewCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsOverviewCall.Do() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullFloat_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    NullFloat
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"string", `"1.5"`, NullFloat{1.5, true}, false},
+		{"zero", `"0"`, NullFloat{0, true}, false},
+		{"number", `-2`, NullFloat{-2, true}, false},
+		{"None", `"None"`, NullFloat{}, false},
+		{"dash", `"-"`, NullFloat{}, false},
+		{"empty", `""`, NullFloat{}, false},
+		{"null", `null`, NullFloat{}, false},
+		{"invalid", `"abc"`, NullFloat{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NullFloat{Float64: 9, Valid: true}
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NullFloat.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("NullFloat.UnmarshalJSON() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsIncomeStatementCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsIncomeStatementCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewFundamentalsService(avTest).IncomeStatement("IBM"), "https://www.alphavantage.co/query?apikey=key&function=INCOME_STATEMENT&symbol=IBM", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsIncomeStatementCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsIncomeStatementCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsIncomeStatementCall_Do(t *testing.T) {
+	client := clientTest("key", `{
+    "symbol": "IBM",
+    "annualReports": [
+        {
+            "fiscalDateEnding": "2019-12-31",
+            "reportedCurrency": "USD",
+            "totalRevenue": "77147000000",
+            "researchAndDevelopment": "None",
+            "ebitda": "0"
+        }
+    ],
+    "quarterlyReports": [
+        {
+            "fiscalDateEnding": "2020-09-30",
+            "reportedCurrency": "USD",
+            "totalRevenue": "77147000000",
+            "researchAndDevelopment": "None",
+            "ebitda": "0"
+        }
+    ]
+}`, http.StatusOK)
+	avTest, _ := New(client)
+
+	avEmpty, _ := New(clientTest("key", "{}", http.StatusOK))
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsIncomeStatementCall
+		want    *IncomeStatementList
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewFundamentalsService(avTest).IncomeStatement("IBM"), &IncomeStatementList{
+			ServerResponse: ServerResponse{HTTPStatusCode: http.StatusOK, Header: http.Header{}},
+			Symbol:         "IBM",
+			AnnualReports: []*IncomeStatement{
+				{FiscalDateEnding: Time(time.Date(2019, time.December, 31, 0, 0, 0, 0, eastern)), ReportedCurrency: "USD", TotalRevenue: NullFloat{77147000000, true}, ResearchAndDevelopment: NullFloat{}, EBITDA: NullFloat{0, true}},
+			},
+			QuarterlyReports: []*IncomeStatement{
+				{FiscalDateEnding: Time(time.Date(2020, time.September, 30, 0, 0, 0, 0, eastern)), ReportedCurrency: "USD", TotalRevenue: NullFloat{77147000000, true}, ResearchAndDevelopment: NullFloat{}, EBITDA: NullFloat{0, true}},
+			},
+		}, false},
+		{"Not Found", NewFundamentalsService(avEmpty).IncomeStatement("XXX"), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsIncomeStatementCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsIncomeStatementCall.Do() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsBalanceSheetCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsBalanceSheetCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewFundamentalsService(avTest).BalanceSheet("IBM"), "https://www.alphavantage.co/query?apikey=key&function=BALANCE_SHEET&symbol=IBM", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsBalanceSheetCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsBalanceSheetCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsBalanceSheetCall_Do(t *testing.T) {
+	client := clientTest("key", `{
+    "symbol": "IBM",
+    "annualReports": [
+        {
+            "fiscalDateEnding": "2019-12-31",
+            "reportedCurrency": "USD",
+            "totalAssets": "152186000000",
+            "goodwill": "None",
+            "treasuryStock": "-169413000000"
+        }
+    ],
+    "quarterlyReports": [
+        {
+            "fiscalDateEnding": "2020-09-30",
+            "reportedCurrency": "USD",
+            "totalAssets": "152186000000",
+            "goodwill": "None",
+            "treasuryStock": "-169413000000"
+        }
+    ]
+}`, http.StatusOK)
+	avTest, _ := New(client)
+
+	avEmpty, _ := New(clientTest("key", "{}", http.StatusOK))
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsBalanceSheetCall
+		want    *BalanceSheetList
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewFundamentalsService(avTest).BalanceSheet("IBM"), &BalanceSheetList{
+			ServerResponse: ServerResponse{HTTPStatusCode: http.StatusOK, Header: http.Header{}},
+			Symbol:         "IBM",
+			AnnualReports: []*BalanceSheet{
+				{FiscalDateEnding: Time(time.Date(2019, time.December, 31, 0, 0, 0, 0, eastern)), ReportedCurrency: "USD", TotalAssets: NullFloat{152186000000, true}, Goodwill: NullFloat{}, TreasuryStock: NullFloat{-169413000000, true}},
+			},
+			QuarterlyReports: []*BalanceSheet{
+				{FiscalDateEnding: Time(time.Date(2020, time.September, 30, 0, 0, 0, 0, eastern)), ReportedCurrency: "USD", TotalAssets: NullFloat{152186000000, true}, Goodwill: NullFloat{}, TreasuryStock: NullFloat{-169413000000, true}},
+			},
+		}, false},
+		{"Not Found", NewFundamentalsService(avEmpty).BalanceSheet("XXX"), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsBalanceSheetCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsBalanceSheetCall.Do() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsCashFlowCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsCashFlowCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewFundamentalsService(avTest).CashFlow("IBM"), "https://www.alphavantage.co/query?apikey=key&function=CASH_FLOW&symbol=IBM", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsCashFlowCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsCashFlowCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsCashFlowCall_Do(t *testing.T) {
+	client := clientTest("key", `{
+    "symbol": "IBM",
+    "annualReports": [
+        {
+            "fiscalDateEnding": "2019-12-31",
+            "reportedCurrency": "USD",
+            "operatingCashflow": "14770000000",
+            "changeInExchangeRate": "None",
+            "dividendPayout": "5707000000"
+        }
+    ],
+    "quarterlyReports": [
+        {
+            "fiscalDateEnding": "2020-09-30",
+            "reportedCurrency": "USD",
+            "operatingCashflow": "14770000000",
+            "changeInExchangeRate": "None",
+            "dividendPayout": "5707000000"
+        }
+    ]
+}`, http.StatusOK)
+	avTest, _ := New(client)
+
+	avEmpty, _ := New(clientTest("key", "{}", http.StatusOK))
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsCashFlowCall
+		want    *CashFlowList
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewFundamentalsService(avTest).CashFlow("IBM"), &CashFlowList{
+			ServerResponse: ServerResponse{HTTPStatusCode: http.StatusOK, Header: http.Header{}},
+			Symbol:         "IBM",
+			AnnualReports: []*CashFlow{
+				{FiscalDateEnding: Time(time.Date(2019, time.December, 31, 0, 0, 0, 0, eastern)), ReportedCurrency: "USD", OperatingCashflow: NullFloat{14770000000, true}, ChangeInExchangeRate: NullFloat{}, DividendPayout: NullFloat{5707000000, true}},
+			},
+			QuarterlyReports: []*CashFlow{
+				{FiscalDateEnding: Time(time.Date(2020, time.September, 30, 0, 0, 0, 0, eastern)), ReportedCurrency: "USD", OperatingCashflow: NullFloat{14770000000, true}, ChangeInExchangeRate: NullFloat{}, DividendPayout: NullFloat{5707000000, true}},
+			},
+		}, false},
+		{"Not Found", NewFundamentalsService(avEmpty).CashFlow("XXX"), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsCashFlowCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsCashFlowCall.Do() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsEarningsCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsEarningsCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewFundamentalsService(avTest).Earnings("IBM"), "https://www.alphavantage.co/query?apikey=key&function=EARNINGS&symbol=IBM", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsEarningsCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsEarningsCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsEarningsCall_Do(t *testing.T) {
+	client := clientTest("key", `{
+    "symbol": "IBM",
+    "annualEarnings": [
+        {
+            "fiscalDateEnding": "2020-09-30",
+            "reportedEPS": "7.46"
+        }
+    ],
+    "quarterlyEarnings": [
+        {
+            "fiscalDateEnding": "2020-09-30",
+            "reportedDate": "2020-10-19",
+            "reportedEPS": "2.58",
+            "estimatedEPS": "2.57",
+            "surprise": "0.01",
+            "surprisePercentage": "0.3891"
+        },
+        {
+            "fiscalDateEnding": "2020-06-30",
+            "reportedDate": "2020-07-20",
+            "reportedEPS": "2.18",
+            "estimatedEPS": "None",
+            "surprise": "0",
+            "surprisePercentage": "None"
+        }
+    ]
+}`, http.StatusOK)
+	avTest, _ := New(client)
+
+	avEmpty, _ := New(clientTest("key", "{}", http.StatusOK))
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsEarningsCall
+		want    *EarningsList
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewFundamentalsService(avTest).Earnings("IBM"), &EarningsList{
+			ServerResponse: ServerResponse{HTTPStatusCode: http.StatusOK, Header: http.Header{}},
+			Symbol:         "IBM",
+			AnnualEarnings: []*AnnualEarning{
+				{FiscalDateEnding: Time(time.Date(2020, time.September, 30, 0, 0, 0, 0, eastern)), ReportedEPS: NullFloat{7.46, true}},
+			},
+			QuarterlyEarnings: []*QuarterlyEarning{
+				{
+					FiscalDateEnding:   Time(time.Date(2020, time.September, 30, 0, 0, 0, 0, eastern)),
+					ReportedDate:       NullTime{Time(time.Date(2020, time.October, 19, 0, 0, 0, 0, eastern)), true},
+					ReportedEPS:        NullFloat{2.58, true},
+					EstimatedEPS:       NullFloat{2.57, true},
+					Surprise:           NullFloat{0.01, true},
+					SurprisePercentage: NullPercent{0.3891, true},
+				},
+				{
+					FiscalDateEnding: Time(time.Date(2020, time.June, 30, 0, 0, 0, 0, eastern)),
+					ReportedDate:     NullTime{Time(time.Date(2020, time.July, 20, 0, 0, 0, 0, eastern)), true},
+					ReportedEPS:      NullFloat{2.18, true},
+					Surprise:         NullFloat{0, true},
+				},
+			},
+		}, false},
+		{"Not Found", NewFundamentalsService(avEmpty).Earnings("XXX"), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsEarningsCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsEarningsCall.Do() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsEarningsCalendarCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsEarningsCalendarCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"3month", NewFundamentalsService(avTest).EarningsCalendar(EarningsHorizonThreeMonth), "https://www.alphavantage.co/query?apikey=key&function=EARNINGS_CALENDAR&horizon=3month", false},
+		{"6month", NewFundamentalsService(avTest).EarningsCalendar(EarningsHorizonSixMonth), "https://www.alphavantage.co/query?apikey=key&function=EARNINGS_CALENDAR&horizon=6month", false},
+		{"12month symbol", NewFundamentalsService(avTest).EarningsCalendar(EarningsHorizonTwelveMonth).Symbol("IBM"), "https://www.alphavantage.co/query?apikey=key&function=EARNINGS_CALENDAR&horizon=12month&symbol=IBM", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsEarningsCalendarCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsEarningsCalendarCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsEarningsCalendarCall_Do(t *testing.T) {
+	client := clientTest("key", `symbol,name,reportDate,fiscalDateEnding,estimate,currency
+IBM,International Business Machines Corp,2021-01-21,2020-12-31,1.79,USD
+ZZZ,Example Corp,2021-02-10,2020-12-31,,USD`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsEarningsCalendarCall
+		want    []*EarningsCalendar
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewFundamentalsService(avTest).EarningsCalendar(EarningsHorizonThreeMonth), []*EarningsCalendar{
+			{Symbol: "IBM", Name: "International Business Machines Corp", ReportDate: Time(time.Date(2021, time.January, 21, 0, 0, 0, 0, eastern)), FiscalDateEnding: Time(time.Date(2020, time.December, 31, 0, 0, 0, 0, eastern)), Estimate: NullFloat{1.79, true}, Currency: "USD"},
+			{Symbol: "ZZZ", Name: "Example Corp", ReportDate: Time(time.Date(2021, time.February, 10, 0, 0, 0, 0, eastern)), FiscalDateEnding: Time(time.Date(2020, time.December, 31, 0, 0, 0, 0, eastern)), Currency: "USD"},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsEarningsCalendarCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.EarningsCalendar
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsEarningsCalendarCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsListingStatusCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsListingStatusCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewFundamentalsService(avTest).ListingStatus(), "https://www.alphavantage.co/query?apikey=key&function=LISTING_STATUS", false},
+		{"delisted", NewFundamentalsService(avTest).ListingStatus().State(ListingStateDelisted), "https://www.alphavantage.co/query?apikey=key&function=LISTING_STATUS&state=delisted", false},
+		{"date", NewFundamentalsService(avTest).ListingStatus().Date(time.Date(2014, time.July, 10, 0, 0, 0, 0, time.UTC)).State(ListingStateActive), "https://www.alphavantage.co/query?apikey=key&date=2014-07-10&function=LISTING_STATUS&state=active", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsListingStatusCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsListingStatusCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsListingStatusCall_Do(t *testing.T) {
+	client := clientTest("key", `symbol,name,exchange,assetType,ipoDate,delistingDate,status
+A,Agilent Technologies Inc,NYSE,Stock,1999-11-18,null,Active
+AAA,Listed Funds Trust - AAF First Priority CLO Bond ETF,NYSE ARCA,ETF,2020-09-09,2021-03-15,Delisted`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsListingStatusCall
+		want    []*ListingStatus
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewFundamentalsService(avTest).ListingStatus(), []*ListingStatus{
+			{Symbol: "A", Name: "Agilent Technologies Inc", Exchange: "NYSE", AssetType: "Stock", IPODate: Time(time.Date(1999, time.November, 18, 0, 0, 0, 0, eastern)), Status: "Active"},
+			{Symbol: "AAA", Name: "Listed Funds Trust - AAF First Priority CLO Bond ETF", Exchange: "NYSE ARCA", AssetType: "ETF", IPODate: Time(time.Date(2020, time.September, 9, 0, 0, 0, 0, eastern)), DelistingDate: NullTime{Time(time.Date(2021, time.March, 15, 0, 0, 0, 0, eastern)), true}, Status: "Delisted"},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsListingStatusCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.ListingStatus
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsListingStatusCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsIPOCalendarCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsIPOCalendarCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewFundamentalsService(avTest).IPOCalendar(), "https://www.alphavantage.co/query?apikey=key&function=IPO_CALENDAR", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsIPOCalendarCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsIPOCalendarCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFundamentalsIPOCalendarCall_Do(t *testing.T) {
+	client := clientTest("key", `symbol,name,ipoDate,priceRangeLow,priceRangeHigh,currency,exchange
+ABCD,Example Holdings Inc,2021-03-25,14.00,16.00,USD,NASDAQ
+WXYZ,Example Acquisition Corp,2021-03-26,0,0,USD,NYSE`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *FundamentalsIPOCalendarCall
+		want    []*IPOCalendar
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewFundamentalsService(avTest).IPOCalendar(), []*IPOCalendar{
+			{Symbol: "ABCD", Name: "Example Holdings Inc", IPODate: Time(time.Date(2021, time.March, 25, 0, 0, 0, 0, eastern)), PriceRangeLow: NullFloat{14, true}, PriceRangeHigh: NullFloat{16, true}, Currency: "USD", Exchange: "NASDAQ"},
+			{Symbol: "WXYZ", Name: "Example Acquisition Corp", IPODate: Time(time.Date(2021, time.March, 26, 0, 0, 0, 0, eastern)), PriceRangeLow: NullFloat{0, true}, PriceRangeHigh: NullFloat{0, true}, Currency: "USD", Exchange: "NYSE"},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FundamentalsIPOCalendarCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.IPOCalendar
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FundamentalsIPOCalendarCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,392 @@
+// Package indicators computes technical indicators locally from an
+// alphavantage.TimeSeriesList, so no API call is spent per indicator.
+//
+// The results have the same types as TechnicalIndicatorsService, newest data point first,
+// so the local and the server side values are interchangeable.
+package indicators
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/z-Wind/alphavantage"
+)
+
+// Price the price used to calculate an indicator
+type Price int
+
+// Prices
+const (
+	Close Price = iota
+	// AdjustedClose uses adjusted_close, high and low are scaled by the same adjustment
+	AdjustedClose
+)
+
+// bars prices of a time series in chronological order
+type bars struct {
+	time   []alphavantage.Time
+	high   []float64
+	low    []float64
+	close  []float64
+	volume []float64
+}
+
+func newBars(list *alphavantage.TimeSeriesList, price Price) (*bars, error) {
+	if list == nil {
+		return nil, errors.New("list is nil")
+	}
+
+	ts := make([]*alphavantage.TimeSeries, len(list.TimeSeries))
+	copy(ts, list.TimeSeries)
+	sort.SliceStable(ts, func(i, j int) bool {
+		return time.Time(ts[i].Time).Before(time.Time(ts[j].Time))
+	})
+
+	b := &bars{
+		time:   make([]alphavantage.Time, len(ts)),
+		high:   make([]float64, len(ts)),
+		low:    make([]float64, len(ts)),
+		close:  make([]float64, len(ts)),
+		volume: make([]float64, len(ts)),
+	}
+	for i, t := range ts {
+		factor := 1.0
+		switch price {
+		case Close:
+		case AdjustedClose:
+			// a non-adjusted time series, e.g. Daily, has no adjusted_close,
+			// which would scale every price to 0
+			if t.AdjustedClose == 0 && t.Close != 0 {
+				return nil, errors.Errorf("%v has no adjusted close", t.Time)
+			}
+			if t.Close != 0 {
+				factor = t.AdjustedClose / t.Close
+			}
+		default:
+			return nil, errors.Errorf("unknown price %d", price)
+		}
+
+		b.time[i] = t.Time
+		b.high[i] = t.High * factor
+		b.low[i] = t.Low * factor
+		b.close[i] = t.Close * factor
+		b.volume[i] = t.Volume
+	}
+
+	return b, nil
+}
+
+func checkPeriod(periods ...int) error {
+	for _, p := range periods {
+		if p < 1 {
+			return errors.Errorf("period %d should be positive", p)
+		}
+	}
+	return nil
+}
+
+// sma simple moving average, NaN before the first full period
+func sma(v []float64, n int) []float64 {
+	out := nan(len(v))
+	sum := 0.0
+	for i := range v {
+		sum += v[i]
+		if i >= n {
+			sum -= v[i-n]
+		}
+		if i >= n-1 {
+			out[i] = sum / float64(n)
+		}
+	}
+	return out
+}
+
+// ema exponential moving average seeded by the sma of the first n values,
+// NaN values at the head of v are skipped
+func ema(v []float64, n int) []float64 {
+	out := nan(len(v))
+	start := 0
+	for start < len(v) && math.IsNaN(v[start]) {
+		start++
+	}
+	if len(v)-start < n {
+		return out
+	}
+
+	sum := 0.0
+	for i := start; i < start+n; i++ {
+		sum += v[i]
+	}
+	out[start+n-1] = sum / float64(n)
+
+	k := 2 / float64(n+1)
+	for i := start + n; i < len(v); i++ {
+		out[i] = (v[i]-out[i-1])*k + out[i-1]
+	}
+	return out
+}
+
+// wilder Wilder's smoothing seeded by the mean of v[start:start+n]
+func wilder(v []float64, start, n int) []float64 {
+	out := nan(len(v))
+	if len(v)-start < n {
+		return out
+	}
+
+	sum := 0.0
+	for i := start; i < start+n; i++ {
+		sum += v[i]
+	}
+	out[start+n-1] = sum / float64(n)
+
+	for i := start + n; i < len(v); i++ {
+		out[i] = (out[i-1]*float64(n-1) + v[i]) / float64(n)
+	}
+	return out
+}
+
+func nan(n int) []float64 {
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = math.NaN()
+	}
+	return v
+}
+
+// SMA simple moving average, the same as TechnicalIndicatorsService.SMA
+func SMA(list *alphavantage.TimeSeriesList, price Price, timePeriod int) (*alphavantage.SMAList, error) {
+	if err := checkPeriod(timePeriod); err != nil {
+		return nil, err
+	}
+	b, err := newBars(list, price)
+	if err != nil {
+		return nil, errors.Wrapf(err, "newBars")
+	}
+
+	v := sma(b.close, timePeriod)
+
+	ret := &alphavantage.SMAList{}
+	for i := len(v) - 1; i >= 0 && !math.IsNaN(v[i]); i-- {
+		ret.SMA = append(ret.SMA, &alphavantage.SMA{Time: b.time[i], SMA: v[i]})
+	}
+	return ret, nil
+}
+
+// EMA exponential moving average, the same as TechnicalIndicatorsService.EMA
+func EMA(list *alphavantage.TimeSeriesList, price Price, timePeriod int) (*alphavantage.EMAList, error) {
+	if err := checkPeriod(timePeriod); err != nil {
+		return nil, err
+	}
+	b, err := newBars(list, price)
+	if err != nil {
+		return nil, errors.Wrapf(err, "newBars")
+	}
+
+	v := ema(b.close, timePeriod)
+
+	ret := &alphavantage.EMAList{}
+	for i := len(v) - 1; i >= 0 && !math.IsNaN(v[i]); i-- {
+		ret.EMA = append(ret.EMA, &alphavantage.EMA{Time: b.time[i], EMA: v[i]})
+	}
+	return ret, nil
+}
+
+// RSI relative strength index with Wilder's smoothing, the same as TechnicalIndicatorsService.RSI
+func RSI(list *alphavantage.TimeSeriesList, price Price, timePeriod int) (*alphavantage.RSIList, error) {
+	if err := checkPeriod(timePeriod); err != nil {
+		return nil, err
+	}
+	b, err := newBars(list, price)
+	if err != nil {
+		return nil, errors.Wrapf(err, "newBars")
+	}
+
+	gain := make([]float64, len(b.close))
+	loss := make([]float64, len(b.close))
+	for i := 1; i < len(b.close); i++ {
+		d := b.close[i] - b.close[i-1]
+		if d > 0 {
+			gain[i] = d
+		} else {
+			loss[i] = -d
+		}
+	}
+	avgGain := wilder(gain, 1, timePeriod)
+	avgLoss := wilder(loss, 1, timePeriod)
+
+	ret := &alphavantage.RSIList{}
+	for i := len(b.close) - 1; i >= 0 && !math.IsNaN(avgGain[i]); i-- {
+		rsi := 100.0
+		if avgLoss[i] != 0 {
+			rsi = 100 - 100/(1+avgGain[i]/avgLoss[i])
+		}
+		ret.RSI = append(ret.RSI, &alphavantage.RSI{Time: b.time[i], RSI: rsi})
+	}
+	return ret, nil
+}
+
+// MACD moving average convergence / divergence, the same as TechnicalIndicatorsService.MACD
+func MACD(list *alphavantage.TimeSeriesList, price Price, fastPeriod, slowPeriod, signalPeriod int) (*alphavantage.MACDList, error) {
+	if err := checkPeriod(fastPeriod, slowPeriod, signalPeriod); err != nil {
+		return nil, err
+	}
+	if fastPeriod > slowPeriod {
+		fastPeriod, slowPeriod = slowPeriod, fastPeriod
+	}
+	b, err := newBars(list, price)
+	if err != nil {
+		return nil, errors.Wrapf(err, "newBars")
+	}
+
+	// like TA-Lib, both EMAs start at the slow period, so the fast one is
+	// seeded by the sma of the fastPeriod values ending there
+	fast := nan(len(b.close))
+	if len(b.close) >= slowPeriod {
+		copy(fast[slowPeriod-fastPeriod:], ema(b.close[slowPeriod-fastPeriod:], fastPeriod))
+	}
+	slow := ema(b.close, slowPeriod)
+	macd := nan(len(b.close))
+	for i := range macd {
+		macd[i] = fast[i] - slow[i]
+	}
+	signal := ema(macd, signalPeriod)
+
+	ret := &alphavantage.MACDList{}
+	for i := len(b.close) - 1; i >= 0 && !math.IsNaN(signal[i]); i-- {
+		ret.MACD = append(ret.MACD, &alphavantage.MACD{
+			Time:       b.time[i],
+			MACD:       macd[i],
+			MACDHist:   macd[i] - signal[i],
+			MACDSignal: signal[i],
+		})
+	}
+	return ret, nil
+}
+
+// BBANDS Bollinger bands of a simple moving average and the population standard deviation,
+// the same as TechnicalIndicatorsService.BBANDS
+func BBANDS(list *alphavantage.TimeSeriesList, price Price, timePeriod int, nbDevUp, nbDevDn float64) (*alphavantage.BBANDSList, error) {
+	if err := checkPeriod(timePeriod); err != nil {
+		return nil, err
+	}
+	b, err := newBars(list, price)
+	if err != nil {
+		return nil, errors.Wrapf(err, "newBars")
+	}
+
+	middle := sma(b.close, timePeriod)
+
+	ret := &alphavantage.BBANDSList{}
+	for i := len(b.close) - 1; i >= 0 && !math.IsNaN(middle[i]); i-- {
+		variance := 0.0
+		for _, c := range b.close[i-timePeriod+1 : i+1] {
+			variance += (c - middle[i]) * (c - middle[i])
+		}
+		sd := math.Sqrt(variance / float64(timePeriod))
+
+		ret.BBANDS = append(ret.BBANDS, &alphavantage.BBANDS{
+			Time:           b.time[i],
+			RealUpperBand:  middle[i] + nbDevUp*sd,
+			RealMiddleBand: middle[i],
+			RealLowerBand:  middle[i] - nbDevDn*sd,
+		})
+	}
+	return ret, nil
+}
+
+// ATR average true range with Wilder's smoothing, the same as TechnicalIndicatorsService.ATR
+func ATR(list *alphavantage.TimeSeriesList, price Price, timePeriod int) (*alphavantage.ATRList, error) {
+	if err := checkPeriod(timePeriod); err != nil {
+		return nil, err
+	}
+	b, err := newBars(list, price)
+	if err != nil {
+		return nil, errors.Wrapf(err, "newBars")
+	}
+
+	tr := make([]float64, len(b.close))
+	for i := 1; i < len(b.close); i++ {
+		tr[i] = math.Max(b.high[i]-b.low[i], math.Max(math.Abs(b.high[i]-b.close[i-1]), math.Abs(b.low[i]-b.close[i-1])))
+	}
+	atr := wilder(tr, 1, timePeriod)
+
+	ret := &alphavantage.ATRList{}
+	for i := len(b.close) - 1; i >= 0 && !math.IsNaN(atr[i]); i-- {
+		ret.ATR = append(ret.ATR, &alphavantage.ATR{Time: b.time[i], ATR: atr[i]})
+	}
+	return ret, nil
+}
+
+// OBV on balance volume starting from the first volume, the same as TechnicalIndicatorsService.OBV
+func OBV(list *alphavantage.TimeSeriesList, price Price) (*alphavantage.OBVList, error) {
+	b, err := newBars(list, price)
+	if err != nil {
+		return nil, errors.Wrapf(err, "newBars")
+	}
+
+	obv := make([]float64, len(b.close))
+	for i := range b.close {
+		switch {
+		case i == 0:
+			obv[i] = b.volume[i]
+		case b.close[i] > b.close[i-1]:
+			obv[i] = obv[i-1] + b.volume[i]
+		case b.close[i] < b.close[i-1]:
+			obv[i] = obv[i-1] - b.volume[i]
+		default:
+			obv[i] = obv[i-1]
+		}
+	}
+
+	ret := &alphavantage.OBVList{}
+	for i := len(obv) - 1; i >= 0; i-- {
+		ret.OBV = append(ret.OBV, &alphavantage.OBV{Time: b.time[i], OBV: obv[i]})
+	}
+	return ret, nil
+}
+
+// STOCH stochastic oscillator with simple moving averages, the same as TechnicalIndicatorsService.STOCH
+func STOCH(list *alphavantage.TimeSeriesList, price Price, fastKPeriod, slowKPeriod, slowDPeriod int) (*alphavantage.STOCHList, error) {
+	if err := checkPeriod(fastKPeriod, slowKPeriod, slowDPeriod); err != nil {
+		return nil, err
+	}
+	b, err := newBars(list, price)
+	if err != nil {
+		return nil, errors.Wrapf(err, "newBars")
+	}
+
+	fastK := nan(len(b.close))
+	for i := fastKPeriod - 1; i < len(b.close); i++ {
+		hh, ll := math.Inf(-1), math.Inf(1)
+		for j := i - fastKPeriod + 1; j <= i; j++ {
+			hh = math.Max(hh, b.high[j])
+			ll = math.Min(ll, b.low[j])
+		}
+		fastK[i] = 0
+		if hh != ll {
+			fastK[i] = 100 * (b.close[i] - ll) / (hh - ll)
+		}
+	}
+	slowK := smaSkipNaN(fastK, slowKPeriod)
+	slowD := smaSkipNaN(slowK, slowDPeriod)
+
+	ret := &alphavantage.STOCHList{}
+	for i := len(b.close) - 1; i >= 0 && !math.IsNaN(slowD[i]); i-- {
+		ret.STOCH = append(ret.STOCH, &alphavantage.STOCH{Time: b.time[i], SlowK: slowK[i], SlowD: slowD[i]})
+	}
+	return ret, nil
+}
+
+// smaSkipNaN sma of v with the NaN values at the head skipped
+func smaSkipNaN(v []float64, n int) []float64 {
+	start := 0
+	for start < len(v) && math.IsNaN(v[start]) {
+		start++
+	}
+
+	out := nan(len(v))
+	copy(out[start:], sma(v[start:], n))
+	return out
+}
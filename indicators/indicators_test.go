@@ -0,0 +1,432 @@
+package indicators
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/z-Wind/alphavantage"
+)
+
+type testTransport map[string]string
+
+// RoundTrip returns the body of the requested function
+func (t testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var res http.Response
+	res.StatusCode = http.StatusOK
+	res.Body = ioutil.NopCloser(strings.NewReader(t[req.URL.Query().Get("function")]))
+	res.Header = http.Header{}
+	res.Request = req
+
+	return &res, nil
+}
+
+func day(d int) alphavantage.Time {
+	eastern, _ := time.LoadLocation("US/Eastern")
+	return alphavantage.Time(time.Date(2020, time.March, d, 0, 0, 0, 0, eastern))
+}
+
+// testList closes 1, 2, ..., n from March 1, newest first like the API
+func testList(n int) *alphavantage.TimeSeriesList {
+	list := &alphavantage.TimeSeriesList{}
+	for i := n; i >= 1; i-- {
+		c := float64(i)
+		list.TimeSeries = append(list.TimeSeries, &alphavantage.TimeSeries{
+			Time:          day(i),
+			Open:          c,
+			High:          c + 1,
+			Low:           c - 1,
+			Close:         c,
+			Volume:        100,
+			AdjustedClose: c / 2,
+		})
+	}
+	return list
+}
+
+func equalTime(a, b alphavantage.Time) bool {
+	return time.Time(a).Equal(time.Time(b))
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-4
+}
+
+func TestSMA(t *testing.T) {
+	tests := []struct {
+		name    string
+		price   Price
+		period  int
+		want    []*alphavantage.SMA
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Close", Close, 3, []*alphavantage.SMA{{Time: day(5), SMA: 4}, {Time: day(4), SMA: 3}, {Time: day(3), SMA: 2}}, false},
+		{"AdjustedClose", AdjustedClose, 3, []*alphavantage.SMA{{Time: day(5), SMA: 2}, {Time: day(4), SMA: 1.5}, {Time: day(3), SMA: 1}}, false},
+		{"TooLong", Close, 6, nil, false},
+		{"Period", Close, 0, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SMA(testList(5), tt.price, tt.period)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SMA() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if len(got.SMA) != len(tt.want) {
+				t.Fatalf("SMA() = %v, want %v", got.SMA, tt.want)
+			}
+			for i := range tt.want {
+				if !equalTime(got.SMA[i].Time, tt.want[i].Time) || !almostEqual(got.SMA[i].SMA, tt.want[i].SMA) {
+					t.Errorf("SMA()[%d] = %v, want %v", i, got.SMA[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSMA_noAdjustedClose(t *testing.T) {
+	list := testList(5)
+	for _, ts := range list.TimeSeries {
+		ts.AdjustedClose = 0
+	}
+
+	if _, err := SMA(list, AdjustedClose, 3); err == nil {
+		t.Errorf("SMA() error = nil, want error for a list without adjusted close")
+	}
+	if _, err := SMA(list, Close, 3); err != nil {
+		t.Errorf("SMA() error = %v", err)
+	}
+}
+
+func TestEMA(t *testing.T) {
+	got, err := EMA(testList(5), Close, 3)
+	if err != nil {
+		t.Fatalf("EMA() error = %v", err)
+	}
+	// seeded by SMA 2 at day 3, then k = 0.5 lags the linear series by 1
+	want := []*alphavantage.EMA{{Time: day(5), EMA: 4}, {Time: day(4), EMA: 3}, {Time: day(3), EMA: 2}}
+	if len(got.EMA) != len(want) {
+		t.Fatalf("EMA() = %v, want %v", got.EMA, want)
+	}
+	for i := range want {
+		if !equalTime(got.EMA[i].Time, want[i].Time) || !almostEqual(got.EMA[i].EMA, want[i].EMA) {
+			t.Errorf("EMA()[%d] = %v, want %v", i, got.EMA[i], want[i])
+		}
+	}
+}
+
+func TestRSI(t *testing.T) {
+	list := testList(5)
+	// closes 1, 2, 3, 2, 5
+	list.TimeSeries[1].Close = 2
+
+	got, err := RSI(list, Close, 3)
+	if err != nil {
+		t.Fatalf("RSI() error = %v", err)
+	}
+	// day 4: gain 2/3, loss 1/3; day 5: gain (2/3*2+3)/3, loss (1/3*2)/3
+	want := []*alphavantage.RSI{
+		{Time: day(5), RSI: 100 - 100/(1+(13.0/9)/(2.0/9))},
+		{Time: day(4), RSI: 100 - 100/(1+2.0)},
+	}
+	if len(got.RSI) != len(want) {
+		t.Fatalf("RSI() = %v, want %v", got.RSI, want)
+	}
+	for i := range want {
+		if !equalTime(got.RSI[i].Time, want[i].Time) || !almostEqual(got.RSI[i].RSI, want[i].RSI) {
+			t.Errorf("RSI()[%d] = %v, want %v", i, got.RSI[i], want[i])
+		}
+	}
+}
+
+func TestMACD(t *testing.T) {
+	got, err := MACD(testList(10), Close, 2, 4, 3)
+	if err != nil {
+		t.Fatalf("MACD() error = %v", err)
+	}
+	// on a linear series both EMAs lag by (n-1)/2, so MACD is the constant 1
+	if len(got.MACD) != 5 {
+		t.Fatalf("MACD() = %v, want 5 values", got.MACD)
+	}
+	if !equalTime(got.MACD[0].Time, day(10)) || !equalTime(got.MACD[4].Time, day(6)) {
+		t.Errorf("MACD() from %v to %v, want from %v to %v", got.MACD[0].Time, got.MACD[4].Time, day(10), day(6))
+	}
+	for i, m := range got.MACD {
+		if !almostEqual(m.MACD, 1) || !almostEqual(m.MACDSignal, 1) || !almostEqual(m.MACDHist, 0) {
+			t.Errorf("MACD()[%d] = %+v, want MACD 1, signal 1, hist 0", i, m)
+		}
+	}
+}
+
+func TestBBANDS(t *testing.T) {
+	got, err := BBANDS(testList(3), Close, 3, 2, 1)
+	if err != nil {
+		t.Fatalf("BBANDS() error = %v", err)
+	}
+	sd := math.Sqrt(2.0 / 3)
+	want := []*alphavantage.BBANDS{{Time: day(3), RealUpperBand: 2 + 2*sd, RealMiddleBand: 2, RealLowerBand: 2 - sd}}
+	if len(got.BBANDS) != len(want) {
+		t.Fatalf("BBANDS() = %v, want %v", got.BBANDS, want)
+	}
+	g := got.BBANDS[0]
+	if !equalTime(g.Time, want[0].Time) || !almostEqual(g.RealUpperBand, want[0].RealUpperBand) || !almostEqual(g.RealMiddleBand, want[0].RealMiddleBand) || !almostEqual(g.RealLowerBand, want[0].RealLowerBand) {
+		t.Errorf("BBANDS() = %+v, want %+v", g, want[0])
+	}
+}
+
+func TestATR(t *testing.T) {
+	got, err := ATR(testList(5), Close, 2)
+	if err != nil {
+		t.Fatalf("ATR() error = %v", err)
+	}
+	// every true range is 2
+	if len(got.ATR) != 3 {
+		t.Fatalf("ATR() = %v, want 3 values", got.ATR)
+	}
+	for i, a := range got.ATR {
+		if !equalTime(a.Time, day(5-i)) || !almostEqual(a.ATR, 2) {
+			t.Errorf("ATR()[%d] = %v, want %v 2", i, a, day(5-i))
+		}
+	}
+}
+
+func TestOBV(t *testing.T) {
+	list := testList(3)
+	// closes 1, 1, 3
+	list.TimeSeries[1].Close = 1
+
+	got, err := OBV(list, Close)
+	if err != nil {
+		t.Fatalf("OBV() error = %v", err)
+	}
+	want := []*alphavantage.OBV{{Time: day(3), OBV: 200}, {Time: day(2), OBV: 100}, {Time: day(1), OBV: 100}}
+	if len(got.OBV) != len(want) {
+		t.Fatalf("OBV() = %v, want %v", got.OBV, want)
+	}
+	for i := range want {
+		if !equalTime(got.OBV[i].Time, want[i].Time) || got.OBV[i].OBV != want[i].OBV {
+			t.Errorf("OBV()[%d] = %v, want %v", i, got.OBV[i], want[i])
+		}
+	}
+}
+
+func TestSTOCH(t *testing.T) {
+	got, err := STOCH(testList(6), Close, 3, 2, 2)
+	if err != nil {
+		t.Fatalf("STOCH() error = %v", err)
+	}
+	// close is always 3/4 of the way up the 3 day range
+	if len(got.STOCH) != 2 {
+		t.Fatalf("STOCH() = %v, want 2 values", got.STOCH)
+	}
+	for i, s := range got.STOCH {
+		if !equalTime(s.Time, day(6-i)) || !almostEqual(s.SlowK, 75) || !almostEqual(s.SlowD, 75) {
+			t.Errorf("STOCH()[%d] = %+v, want %v 75 75", i, s, day(6-i))
+		}
+	}
+}
+
+// TestSMA_server checks the local SMA against the server side SMA decoded by TechnicalIndicatorsService
+func TestSMA_server(t *testing.T) {
+	var series, sma strings.Builder
+	series.WriteString("timestamp,open,high,low,close,volume\n")
+	sma.WriteString("time,SMA\n")
+	for i := 5; i >= 1; i-- {
+		fmt.Fprintf(&series, "2020-03-%02d,%d,%d,%d,%d,100\n", i, i, i+1, i-1, i)
+		if i >= 3 {
+			fmt.Fprintf(&sma, "2020-03-%02d,%.4f\n", i, float64(i-1))
+		}
+	}
+
+	client := &http.Client{Transport: testTransport{
+		"TIME_SERIES_DAILY": series.String(),
+		"SMA":               sma.String(),
+	}}
+	av, err := alphavantage.New(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := av.TimeSeries.Daily("symbol").Do()
+	if err != nil {
+		t.Fatalf("TimeSeriesDailyCall.Do() error = %v", err)
+	}
+	want, err := av.TechnicalIndicators.SMA("symbol", alphavantage.IndicatorIntervalDaily, 3, alphavantage.SeriesTypeClose).Do()
+	if err != nil {
+		t.Fatalf("SMACall.Do() error = %v", err)
+	}
+
+	got, err := SMA(list, Close, 3)
+	if err != nil {
+		t.Fatalf("SMA() error = %v", err)
+	}
+	if len(got.SMA) != len(want.SMA) {
+		t.Fatalf("SMA() = %v, want %v", got.SMA, want.SMA)
+	}
+	for i := range want.SMA {
+		if !equalTime(got.SMA[i].Time, want.SMA[i].Time) || !almostEqual(got.SMA[i].SMA, want.SMA[i].SMA) {
+			t.Errorf("SMA()[%d] = %v, want %v", i, got.SMA[i], want.SMA[i])
+		}
+	}
+}
+
+// serverSeries non-linear daily closes, newest first like the API
+const serverSeries = `timestamp,open,high,low,close,volume
+2020-03-20,109.0478,110.0478,108.0478,109.0478,100
+2020-03-19,105.5681,106.5681,104.5681,105.5681,100
+2020-03-18,102.0093,103.0093,101.0093,102.0093,100
+2020-03-17,99.9041,100.9041,98.9041,99.9041,100
+2020-03-16,102.1015,103.1015,101.1015,102.1015,100
+2020-03-15,102.3676,103.3676,101.3676,102.3676,100
+2020-03-14,105.4955,106.4955,104.4955,105.4955,100
+2020-03-13,107.8730,108.8730,106.8730,107.8730,100
+2020-03-12,108.2408,109.2408,107.2408,108.2408,100
+2020-03-11,108.2849,109.2849,107.2849,108.2849,100
+2020-03-10,102.7841,103.7841,101.7841,102.7841,100
+2020-03-09,99.2437,100.2437,98.2437,99.2437,100
+2020-03-08,97.1877,98.1877,96.1877,97.1877,100
+2020-03-07,97.4421,98.4421,96.4421,97.4421,100
+2020-03-06,101.7461,102.7461,100.7461,101.7461,100
+2020-03-05,102.8749,103.8749,101.8749,102.8749,100
+2020-03-04,105.2160,106.2160,104.2160,105.2160,100
+2020-03-03,105.5272,106.5272,104.5272,105.5272,100
+2020-03-02,103.5211,104.5211,102.5211,103.5211,100
+2020-03-01,102.0000,103.0000,101.0000,102.0000,100`
+
+// newServerTest returns the TIME_SERIES_DAILY list of serverSeries and a Service
+// whose function returns the server side indicator body.
+// The indicator bodies are computed by TA-Lib, like the server side indicators.
+func newServerTest(t *testing.T, function, body string) (*alphavantage.Service, *alphavantage.TimeSeriesList) {
+	client := &http.Client{Transport: testTransport{
+		"TIME_SERIES_DAILY": serverSeries,
+		function:            body,
+	}}
+	av, err := alphavantage.New(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := av.TimeSeries.Daily("symbol").Do()
+	if err != nil {
+		t.Fatalf("TimeSeriesDailyCall.Do() error = %v", err)
+	}
+	return av, list
+}
+
+// TestEMA_server checks the local EMA against the server side EMA decoded by TechnicalIndicatorsService
+func TestEMA_server(t *testing.T) {
+	av, list := newServerTest(t, "EMA", `time,EMA
+2020-03-20,105.2895
+2020-03-19,103.4104
+2020-03-18,102.3315
+2020-03-17,102.4927
+2020-03-16,103.7869
+2020-03-15,104.6297
+2020-03-14,105.7607
+2020-03-13,105.8933
+2020-03-12,104.9035
+2020-03-11,103.2348
+2020-03-10,100.7097
+2020-03-09,99.6726
+2020-03-08,99.8870
+2020-03-07,101.2367
+2020-03-06,103.1339
+2020-03-05,103.8278`)
+	want, err := av.TechnicalIndicators.EMA("symbol", alphavantage.IndicatorIntervalDaily, 5, alphavantage.SeriesTypeClose).Do()
+	if err != nil {
+		t.Fatalf("EMACall.Do() error = %v", err)
+	}
+
+	got, err := EMA(list, Close, 5)
+	if err != nil {
+		t.Fatalf("EMA() error = %v", err)
+	}
+	if len(got.EMA) != len(want.EMA) {
+		t.Fatalf("EMA() = %v, want %v", got.EMA, want.EMA)
+	}
+	for i := range want.EMA {
+		if !equalTime(got.EMA[i].Time, want.EMA[i].Time) || !almostEqual(got.EMA[i].EMA, want.EMA[i].EMA) {
+			t.Errorf("EMA()[%d] = %v, want %v", i, got.EMA[i], want.EMA[i])
+		}
+	}
+}
+
+// TestMACD_server checks the local MACD against the server side MACD decoded by TechnicalIndicatorsService
+func TestMACD_server(t *testing.T) {
+	av, list := newServerTest(t, "MACD", `time,MACD,MACD_Hist,MACD_Signal
+2020-03-20,1.3499,0.9427,0.4073
+2020-03-19,0.2787,0.4999,-0.2211
+2020-03-18,-0.7443,-0.1899,-0.5544
+2020-03-17,-1.1758,-0.7480,-0.4278
+2020-03-16,-0.6505,-0.7213,0.0708
+2020-03-15,-0.2381,-0.7898,0.5517
+2020-03-14,0.8524,-0.2258,1.0782
+2020-03-13,1.6880,0.4593,1.2287
+2020-03-12,1.9261,1.0036,0.9225
+2020-03-11,1.6015,1.3481,0.2535
+2020-03-10,0.0256,0.6708,-0.6452
+2020-03-09,-1.0967,-0.0043,-1.0925`)
+	want, err := av.TechnicalIndicators.MACD("symbol", alphavantage.IndicatorIntervalDaily, alphavantage.SeriesTypeClose).FastPeriod(3).SlowPeriod(6).SignalPeriod(4).Do()
+	if err != nil {
+		t.Fatalf("MACDCall.Do() error = %v", err)
+	}
+
+	got, err := MACD(list, Close, 3, 6, 4)
+	if err != nil {
+		t.Fatalf("MACD() error = %v", err)
+	}
+	if len(got.MACD) != len(want.MACD) {
+		t.Fatalf("MACD() = %v, want %v", got.MACD, want.MACD)
+	}
+	for i := range want.MACD {
+		g, w := got.MACD[i], want.MACD[i]
+		if !equalTime(g.Time, w.Time) || !almostEqual(g.MACD, w.MACD) || !almostEqual(g.MACDSignal, w.MACDSignal) || !almostEqual(g.MACDHist, w.MACDHist) {
+			t.Errorf("MACD()[%d] = %+v, want %+v", i, g, w)
+		}
+	}
+}
+
+// TestRSI_server checks the local RSI against the server side RSI decoded by TechnicalIndicatorsService
+func TestRSI_server(t *testing.T) {
+	av, list := newServerTest(t, "RSI", `time,RSI
+2020-03-20,72.9273
+2020-03-19,62.4969
+2020-03-18,45.2329
+2020-03-17,29.9789
+2020-03-16,39.0644
+2020-03-15,40.2460
+2020-03-14,56.2446
+2020-03-13,74.1742
+2020-03-12,77.2207
+2020-03-11,77.5261
+2020-03-10,62.8720
+2020-03-09,44.1064
+2020-03-08,26.9544
+2020-03-07,27.7988
+2020-03-06,48.2629`)
+	want, err := av.TechnicalIndicators.RSI("symbol", alphavantage.IndicatorIntervalDaily, 5, alphavantage.SeriesTypeClose).Do()
+	if err != nil {
+		t.Fatalf("RSICall.Do() error = %v", err)
+	}
+
+	got, err := RSI(list, Close, 5)
+	if err != nil {
+		t.Fatalf("RSI() error = %v", err)
+	}
+	if len(got.RSI) != len(want.RSI) {
+		t.Fatalf("RSI() = %v, want %v", got.RSI, want.RSI)
+	}
+	for i := range want.RSI {
+		if !equalTime(got.RSI[i].Time, want.RSI[i].Time) || !almostEqual(got.RSI[i].RSI, want.RSI[i].RSI) {
+			t.Errorf("RSI()[%d] = %v, want %v", i, got.RSI[i], want.RSI[i])
+		}
+	}
+}
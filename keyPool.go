@@ -0,0 +1,134 @@
+package alphavantage
+
+import (
+	"sync"
+	"time"
+)
+
+// KeySelection how a KeyPool picks the apikey of the next request
+type KeySelection int
+
+// KeySelection
+const (
+	// KeySelectionRoundRobin uses the keys in turn
+	KeySelectionRoundRobin KeySelection = iota
+	// KeySelectionLeastRecentlyUsed uses the key which has been idle the longest
+	KeySelectionLeastRecentlyUsed
+)
+
+// DefaultQuarantine is how long a key is not used after a rate limit Note
+const DefaultQuarantine = time.Minute
+
+// KeyPool apikeys shared by the requests of a Transport
+// A key is quarantined after the server replies with a rate limit Note,
+// if every key is quarantined, the one released first is used.
+type KeyPool struct {
+	// Quarantine is how long a key is not used after a rate limit Note.
+	// By default, Quarantine is DefaultQuarantine.
+	Quarantine time.Duration
+
+	mu        sync.Mutex
+	selection KeySelection
+	keys      []*KeyStats
+	next      int
+
+	now func() time.Time
+}
+
+// KeyStats usage counters of a key in a KeyPool
+type KeyStats struct {
+	Key string
+	// Calls is the number of requests sent with Key
+	Calls int64
+	// RateLimited is the number of rate limit Notes replied to Key
+	RateLimited int64
+	// LastUsed is when Key was used last
+	LastUsed time.Time
+	// QuarantinedUntil is when Key is used again after a rate limit Note
+	QuarantinedUntil time.Time
+}
+
+// NewKeyPool creates a KeyPool of keys picked by selection
+func NewKeyPool(selection KeySelection, keys ...string) *KeyPool {
+	p := &KeyPool{selection: selection, now: time.Now}
+	for _, key := range keys {
+		p.keys = append(p.keys, &KeyStats{Key: key})
+	}
+
+	return p
+}
+
+// acquire returns the key of the next request and counts the call
+func (p *KeyPool) acquire() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.keys) == 0 {
+		return ""
+	}
+
+	t := p.now()
+	var k *KeyStats
+	switch p.selection {
+	case KeySelectionLeastRecentlyUsed:
+		for _, s := range p.keys {
+			if s.QuarantinedUntil.After(t) {
+				continue
+			}
+			if k == nil || s.LastUsed.Before(k.LastUsed) {
+				k = s
+			}
+		}
+	default:
+		for i := range p.keys {
+			s := p.keys[(p.next+i)%len(p.keys)]
+			if !s.QuarantinedUntil.After(t) {
+				k = s
+				p.next = (p.next + i + 1) % len(p.keys)
+				break
+			}
+		}
+	}
+
+	// every key is quarantined
+	if k == nil {
+		for _, s := range p.keys {
+			if k == nil || s.QuarantinedUntil.Before(k.QuarantinedUntil) {
+				k = s
+			}
+		}
+	}
+
+	k.Calls++
+	k.LastUsed = t
+	return k.Key
+}
+
+// quarantine stops using key for the Quarantine after a rate limit Note
+func (p *KeyPool) quarantine(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	d := p.Quarantine
+	if d <= 0 {
+		d = DefaultQuarantine
+	}
+	for _, s := range p.keys {
+		if s.Key == key {
+			s.RateLimited++
+			s.QuarantinedUntil = p.now().Add(d)
+		}
+	}
+}
+
+// Stats returns the usage counters of every key, in the order the keys were given
+func (p *KeyPool) Stats() []KeyStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make([]KeyStats, len(p.keys))
+	for i, s := range p.keys {
+		stats[i] = *s
+	}
+	return stats
+}
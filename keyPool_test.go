@@ -0,0 +1,116 @@
+package alphavantage
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestKeyPool_acquire(t *testing.T) {
+	now := time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		selection  KeySelection
+		quarantine string
+		want       []string
+	}{
+		// TODO: Add test cases.
+		{"RoundRobin", KeySelectionRoundRobin, "", []string{"a", "b", "c", "a", "b"}},
+		{"RoundRobin Quarantine", KeySelectionRoundRobin, "b", []string{"a", "c", "a", "c", "a"}},
+		{"LeastRecentlyUsed", KeySelectionLeastRecentlyUsed, "", []string{"a", "b", "c", "a", "b"}},
+		{"LeastRecentlyUsed Quarantine", KeySelectionLeastRecentlyUsed, "a", []string{"b", "c", "b", "c", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewKeyPool(tt.selection, "a", "b", "c")
+			p.now = func() time.Time { return now }
+			if tt.quarantine != "" {
+				p.quarantine(tt.quarantine)
+			}
+
+			var got []string
+			for range tt.want {
+				now = now.Add(time.Second)
+				got = append(got, p.acquire())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KeyPool.acquire() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyPool_allQuarantined(t *testing.T) {
+	now := time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)
+	p := NewKeyPool(KeySelectionRoundRobin, "a", "b")
+	p.now = func() time.Time { return now }
+
+	p.quarantine("b")
+	now = now.Add(time.Second)
+	p.quarantine("a")
+	if got := p.acquire(); got != "b" {
+		t.Errorf("KeyPool.acquire() = %v, want %v released first", got, "b")
+	}
+
+	now = now.Add(DefaultQuarantine)
+	if got := p.acquire(); got != "a" {
+		t.Errorf("KeyPool.acquire() = %v, want %v", got, "a")
+	}
+}
+
+func TestTransport_KeyPool(t *testing.T) {
+	client, st := clientSequence("a", sequenceResponse{noteBody, http.StatusOK}, sequenceResponse{dailyBody, http.StatusOK})
+	pool := NewKeyPool(KeySelectionRoundRobin, "a", "b")
+	client.Transport.(*Transport).pool = pool
+	avTest, _ := New(client)
+	avTest.RetryPolicy = &RetryPolicy{MaxRetries: 1, Backoff: time.Millisecond}
+
+	if _, err := avTest.TimeSeries.Daily("symbol").Do(); err != nil {
+		t.Fatalf("TimeSeriesDailyCall.Do() error = %v", err)
+	}
+	if _, err := avTest.TimeSeries.Daily("symbol").Do(); err != nil {
+		t.Fatalf("TimeSeriesDailyCall.Do() error = %v", err)
+	}
+	if st.calls != 3 {
+		t.Errorf("TimeSeriesDailyCall.Do() calls = %d, want %d", st.calls, 3)
+	}
+
+	// a is quarantined after the Note, so b is used for the retry and the next call
+	stats := pool.Stats()
+	if stats[0].Key != "a" || stats[0].Calls != 1 || stats[0].RateLimited != 1 || stats[0].QuarantinedUntil.IsZero() {
+		t.Errorf("KeyPool.Stats() = %+v, want a used once and rate limited", stats[0])
+	}
+	if stats[1].Key != "b" || stats[1].Calls != 2 || stats[1].RateLimited != 0 {
+		t.Errorf("KeyPool.Stats() = %+v, want b used twice", stats[1])
+	}
+}
+
+func TestGetPoolClient_noKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		client *http.Client
+	}{
+		// TODO: Add test cases.
+		{"GetClient", GetClient()},
+		{"Empty Key", GetClient("")},
+		{"Empty Pool", GetPoolClient(NewKeyPool(KeySelectionRoundRobin))},
+		{"Nil Pool", GetPoolClient(nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &sequenceTransport{responses: []sequenceResponse{{dailyBody, http.StatusOK}}}
+			tt.client.Transport.(*Transport).originalTransport = st
+			avTest, _ := New(tt.client)
+
+			if _, err := avTest.TimeSeries.Daily("symbol").Do(); !errors.Is(err, ErrNoAPIKey) {
+				t.Errorf("TimeSeriesDailyCall.Do() error = %v, want %v", err, ErrNoAPIKey)
+			}
+			if st.calls != 0 {
+				t.Errorf("TimeSeriesDailyCall.Do() calls = %d, want %d", st.calls, 0)
+			}
+		})
+	}
+}
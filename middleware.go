@@ -0,0 +1,88 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RequestInfo describes the call passed to the Middleware hooks
+type RequestInfo struct {
+	// Function is the function parameter, e.g. TIME_SERIES_DAILY
+	Function string
+	// Params are the request parameters without apikey
+	Params url.Values
+	// Start is when Do was called
+	Start time.Time
+	// Duration is the time since Start, set for AfterResponse and OnError
+	Duration time.Duration
+}
+
+func newRequestInfo(params url.Values) *RequestInfo {
+	p := make(url.Values, len(params))
+	for k, v := range params {
+		p[k] = append([]string(nil), v...)
+	}
+	p.Del("apikey")
+
+	return &RequestInfo{
+		Function: params.Get("function"),
+		Params:   p,
+		Start:    time.Now(),
+	}
+}
+
+// Middleware hooks around every Do, e.g. for logging, metrics, auditing or fault injection.
+// A nil hook is skipped.
+type Middleware struct {
+	// BeforeRequest is called before the request is sent, an error aborts the call
+	BeforeRequest func(ctx context.Context, info *RequestInfo) error
+	// AfterResponse is called with the response before it is checked and decoded,
+	// the apikey is removed from res.Request.URL
+	AfterResponse func(ctx context.Context, info *RequestInfo, res *http.Response)
+	// OnError is called with the error returned by Do
+	OnError func(ctx context.Context, info *RequestInfo, err error)
+}
+
+// Use appends middlewares to the chain, whose hooks are called in the order they are added
+func (s *Service) Use(middlewares ...*Middleware) {
+	s.Middlewares = append(s.Middlewares, middlewares...)
+}
+
+func (s *Service) beforeRequest(ctx context.Context, info *RequestInfo) error {
+	for _, m := range s.Middlewares {
+		if m.BeforeRequest == nil {
+			continue
+		}
+		if err := m.BeforeRequest(ctx, info); err != nil {
+			return errors.Wrapf(err, "BeforeRequest")
+		}
+	}
+	return nil
+}
+
+func (s *Service) afterResponse(ctx context.Context, info *RequestInfo, res *http.Response) {
+	info.Duration = time.Since(info.Start)
+	if len(s.Middlewares) == 0 {
+		return
+	}
+
+	res = redactResponse(res)
+	for _, m := range s.Middlewares {
+		if m.AfterResponse != nil {
+			m.AfterResponse(ctx, info, res)
+		}
+	}
+}
+
+func (s *Service) onError(ctx context.Context, info *RequestInfo, err error) {
+	info.Duration = time.Since(info.Start)
+	for _, m := range s.Middlewares {
+		if m.OnError != nil {
+			m.OnError(ctx, info, err)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package alphavantage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestService_Use(t *testing.T) {
+	errFault := errors.New("fault")
+
+	tests := []struct {
+		name      string
+		body      string
+		fault     error
+		wantHooks []string
+		wantCalls int
+		wantErr   error
+	}{
+		// TODO: Add test cases.
+		{"OK", dailyBody, nil, []string{"before 1", "before 2", "after 1 200", "after 2 200"}, 1, nil},
+		{"Note", noteBody, nil, []string{"before 1", "before 2", "after 1 200", "after 2 200", "error 1", "error 2"}, 1, ErrRateLimited},
+		{"Fault", dailyBody, errFault, []string{"before 1", "error 1", "error 2"}, 0, errFault},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, st := clientSequence("key", sequenceResponse{tt.body, http.StatusOK})
+			avTest, _ := New(client)
+
+			var hooks []string
+			for _, n := range []string{"1", "2"} {
+				n := n
+				avTest.Use(&Middleware{
+					BeforeRequest: func(ctx context.Context, info *RequestInfo) error {
+						hooks = append(hooks, "before "+n)
+						if info.Function != "TIME_SERIES_DAILY" || info.Params.Get("symbol") != "symbol" {
+							t.Errorf("RequestInfo = %+v, want TIME_SERIES_DAILY of symbol", info)
+						}
+						return tt.fault
+					},
+					AfterResponse: func(ctx context.Context, info *RequestInfo, res *http.Response) {
+						hooks = append(hooks, "after "+n+" "+strconv.Itoa(res.StatusCode))
+						if info.Start.IsZero() || info.Duration < 0 {
+							t.Errorf("RequestInfo = %+v, want Start and Duration", info)
+						}
+					},
+					OnError: func(ctx context.Context, info *RequestInfo, err error) {
+						hooks = append(hooks, "error "+n)
+						if !errors.Is(err, tt.wantErr) {
+							t.Errorf("OnError() error = %v, want %v", err, tt.wantErr)
+						}
+					},
+				})
+			}
+
+			_, err := avTest.TimeSeries.Daily("symbol").Do()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TimeSeriesDailyCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(hooks, tt.wantHooks) {
+				t.Errorf("hooks = %v, want %v", hooks, tt.wantHooks)
+			}
+			if st.calls != tt.wantCalls {
+				t.Errorf("TimeSeriesDailyCall.Do() calls = %d, want %d", st.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestService_Use_notFound(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		do   func(s *Service) error
+	}{
+		// TODO: Add test cases.
+		{"Overview", `{}`, func(s *Service) error { _, err := s.Fundamentals.Overview("XXX").Do(); return err }},
+		{"ExchangeRate", `{}`, func(s *Service) error { _, err := s.ForeignExchange.ExchangeRate("USD", "XXX").Do(); return err }},
+		{"Rating", `{}`, func(s *Service) error { _, err := s.DigitalCryptoCurrencies.Rating("XXX").Do(); return err }},
+		{"Quote", "symbol,open,high,low,price,volume,latestDay,previousClose,change,changePercent\n", func(s *Service) error { _, err := s.TimeSeries.QuoteEndpoint("XXX").Do(); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avTest, _ := New(clientTest("key", tt.body, http.StatusOK))
+
+			var got error
+			avTest.Use(&Middleware{
+				OnError: func(ctx context.Context, info *RequestInfo, err error) {
+					got = err
+				},
+			})
+
+			err := tt.do(avTest)
+			if err == nil {
+				t.Fatalf("Do() error = nil, want could not be found")
+			}
+			if got != err || !strings.Contains(err.Error(), "could not be found") {
+				t.Errorf("OnError() error = %v, want %v", got, err)
+			}
+		})
+	}
+}
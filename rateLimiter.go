@@ -0,0 +1,184 @@
+package alphavantage
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Alpha Vantage quota https://www.alphavantage.co/premium/
+// The standard API key allows 5 calls per minute and 500 calls per day,
+// premium keys allow 75, 150, 300, 600 or 1200 calls per minute without a daily limit.
+const (
+	FreeCallsPerMinute = 5
+	FreeCallsPerDay    = 500
+)
+
+// RateLimiter a token bucket for the calls per minute and a 24 hour window for the calls per day of an API key
+// Every Do waits on the RateLimiter of its Service before sending the request.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	minute *bucket
+	day    *window
+	calls  int64
+
+	now func() time.Time
+}
+
+// RateLimiterStats remaining budget of a RateLimiter
+type RateLimiterStats struct {
+	// Calls is the number of calls allowed so far
+	Calls int64
+	// RemainingPerMinute is the number of calls which could be sent right now without waiting,
+	// -1 means unlimited
+	RemainingPerMinute int
+	// RemainingPerDay is the number of calls left in the daily quota, -1 means unlimited
+	RemainingPerDay int
+}
+
+// NewRateLimiter creates a RateLimiter allowing perMinute calls per minute and perDay calls per day.
+// A non-positive value means unlimited, e.g. NewRateLimiter(75, 0) for a premium key.
+func NewRateLimiter(perMinute, perDay int) *RateLimiter {
+	l := &RateLimiter{now: time.Now}
+	t := l.now()
+	if perMinute > 0 {
+		l.minute = newBucket(perMinute, time.Minute, t)
+	}
+	if perDay > 0 {
+		l.day = newWindow(perDay, 24*time.Hour, t)
+	}
+
+	return l
+}
+
+// NewFreeRateLimiter creates a RateLimiter for the standard API key
+func NewFreeRateLimiter() *RateLimiter {
+	return NewRateLimiter(FreeCallsPerMinute, FreeCallsPerDay)
+}
+
+// Wait blocks until a call is allowed or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		t := l.now()
+		wait := time.Duration(0)
+		if l.minute != nil {
+			wait = l.minute.wait(t)
+		}
+		if l.day != nil {
+			if d := l.day.wait(t); d > wait {
+				wait = d
+			}
+		}
+		if wait == 0 {
+			if l.minute != nil {
+				l.minute.tokens--
+			}
+			if l.day != nil {
+				l.day.calls++
+			}
+			l.calls++
+			l.mu.Unlock()
+			return nil
+		}
+		l.mu.Unlock()
+
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Stats returns the remaining budget
+func (l *RateLimiter) Stats() RateLimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	t := l.now()
+	stats := RateLimiterStats{
+		Calls:              l.calls,
+		RemainingPerMinute: -1,
+		RemainingPerDay:    -1,
+	}
+	if l.minute != nil {
+		l.minute.refill(t)
+		stats.RemainingPerMinute = int(math.Floor(l.minute.tokens))
+	}
+	if l.day != nil {
+		l.day.reset(t)
+		stats.RemainingPerDay = l.day.size - l.day.calls
+	}
+
+	return stats
+}
+
+// bucket token bucket refilled continuously at size tokens per period
+type bucket struct {
+	size   float64
+	rate   float64 // tokens per nanosecond
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(size int, period time.Duration, t time.Time) *bucket {
+	return &bucket{
+		size:   float64(size),
+		rate:   float64(size) / float64(period),
+		tokens: float64(size),
+		last:   t,
+	}
+}
+
+func (b *bucket) refill(t time.Time) {
+	if t.After(b.last) {
+		b.tokens = math.Min(b.size, b.tokens+float64(t.Sub(b.last))*b.rate)
+		b.last = t
+	}
+}
+
+// wait returns how long to wait for one token
+func (b *bucket) wait(t time.Time) time.Duration {
+	b.refill(t)
+	if b.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration(math.Ceil((1 - b.tokens) / b.rate))
+}
+
+// window allows size calls per period, counted from the start of the window
+// unlike a bucket, no call is given back before the window ends
+type window struct {
+	size   int
+	period time.Duration
+	calls  int
+	start  time.Time
+}
+
+func newWindow(size int, period time.Duration, t time.Time) *window {
+	return &window{
+		size:   size,
+		period: period,
+		start:  t,
+	}
+}
+
+// reset starts a new window once the current one has ended
+func (w *window) reset(t time.Time) {
+	if end := w.start.Add(w.period); !t.Before(end) {
+		w.calls = 0
+		w.start = t
+	}
+}
+
+// wait returns how long to wait for the window to allow one call
+func (w *window) wait(t time.Time) time.Duration {
+	w.reset(t)
+	if w.calls < w.size {
+		return 0
+	}
+
+	return w.start.Add(w.period).Sub(t)
+}
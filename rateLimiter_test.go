@@ -0,0 +1,126 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	now := time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(2, 3)
+	l.now = func() time.Time { return now }
+	l.minute.last, l.day.start = now, now
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	for i := 0; i < 2; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("RateLimiter.Wait() %d error = %v", i, err)
+		}
+	}
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("RateLimiter.Wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got, want := l.Stats(), (RateLimiterStats{Calls: 2, RemainingPerMinute: 0, RemainingPerDay: 1}); !reflect.DeepEqual(got, want) {
+		t.Errorf("RateLimiter.Stats() = %+v, want %+v", got, want)
+	}
+
+	// half a minute refills one call per minute, but the day is almost used up
+	now = now.Add(30 * time.Second)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("RateLimiter.Wait() error = %v", err)
+	}
+	if got, want := l.Stats(), (RateLimiterStats{Calls: 3, RemainingPerMinute: 0, RemainingPerDay: 0}); !reflect.DeepEqual(got, want) {
+		t.Errorf("RateLimiter.Stats() = %+v, want %+v", got, want)
+	}
+
+	now = now.Add(time.Minute)
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("RateLimiter.Wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got, want := l.Stats(), (RateLimiterStats{Calls: 3, RemainingPerMinute: 2, RemainingPerDay: 0}); !reflect.DeepEqual(got, want) {
+		t.Errorf("RateLimiter.Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRateLimiter_WaitPerDay(t *testing.T) {
+	now := time.Date(2020, time.April, 2, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(0, 3)
+	l.now = func() time.Time { return now }
+	l.day.start = now
+
+	// one call every hour would refill a token bucket, but not the window
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("RateLimiter.Wait() %d error = %v", i, err)
+		}
+		now = now.Add(time.Hour)
+	}
+	now = now.Add(20 * time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("RateLimiter.Wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got, want := l.day.wait(now), time.Hour; got != want {
+		t.Errorf("window.wait() = %v, want %v", got, want)
+	}
+	if got, want := l.Stats(), (RateLimiterStats{Calls: 3, RemainingPerMinute: -1, RemainingPerDay: 0}); !reflect.DeepEqual(got, want) {
+		t.Errorf("RateLimiter.Stats() = %+v, want %+v", got, want)
+	}
+
+	// the window resets 24 hours after it started
+	now = now.Add(time.Hour)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("RateLimiter.Wait() error = %v", err)
+	}
+	if got, want := l.Stats(), (RateLimiterStats{Calls: 4, RemainingPerMinute: -1, RemainingPerDay: 2}); !reflect.DeepEqual(got, want) {
+		t.Errorf("RateLimiter.Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRateLimiter_Stats(t *testing.T) {
+	tests := []struct {
+		name string
+		l    *RateLimiter
+		want RateLimiterStats
+	}{
+		// TODO: Add test cases.
+		{"free", NewFreeRateLimiter(), RateLimiterStats{RemainingPerMinute: FreeCallsPerMinute, RemainingPerDay: FreeCallsPerDay}},
+		{"premium", NewRateLimiter(75, 0), RateLimiterStats{RemainingPerMinute: 75, RemainingPerDay: -1}},
+		{"unlimited", NewRateLimiter(0, 0), RateLimiterStats{RemainingPerMinute: -1, RemainingPerDay: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.l.Stats(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RateLimiter.Stats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_RateLimiter(t *testing.T) {
+	client := clientTest("key", `timestamp,open,high,low,close,volume
+2020-03-25,148.9800,149.1000,146.1600,146.8600,2666656`, http.StatusOK)
+	avTest, _ := New(client)
+	avTest.RateLimiter = NewRateLimiter(1, 0)
+
+	if _, err := avTest.TimeSeries.Daily("symbol").Do(); err != nil {
+		t.Fatalf("TimeSeriesDailyCall.Do() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c := avTest.TimeSeries.Daily("symbol")
+	c.Context(ctx)
+	if _, err := c.Do(); errors.Cause(err) != context.Canceled {
+		t.Errorf("TimeSeriesDailyCall.Do() error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,86 @@
+package alphavantage
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// redactedKey replaces the apikey in errors and in what the Middleware hooks receive
+const redactedKey = "REDACTED"
+
+// apikeyPattern matches the apikey parameter of a url, also url-encoded in another url
+var apikeyPattern = regexp.MustCompile(`(?i)(apikey(=|%3D))[^&%\s"'<>]*`)
+
+// redact replaces the value of every apikey parameter in s
+func redact(s string) string {
+	return apikeyPattern.ReplaceAllString(s, "${1}"+redactedKey)
+}
+
+// redactedError an error whose message had the apikey in it
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the original error, so that callers can use errors.Is
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactError removes the apikey from err, its *url.Error and *Error
+func redactError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = redact(urlErr.URL)
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		e.Message = redact(e.Message)
+		e.Body = redact(e.Body)
+		e.Header = redactHeader(e.Header)
+	}
+
+	// an error of another type may still quote the url
+	if msg := err.Error(); msg != redact(msg) {
+		return &redactedError{msg: redact(msg), err: err}
+	}
+	return err
+}
+
+// redactHeader returns a copy of h without apikey
+func redactHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+
+	ret := make(http.Header, len(h))
+	for k, v := range h {
+		ret[k] = make([]string, len(v))
+		for i := range v {
+			ret[k][i] = redact(v[i])
+		}
+	}
+	return ret
+}
+
+// redactResponse returns a shallow copy of res whose request url has no apikey
+func redactResponse(res *http.Response) *http.Response {
+	ret := *res
+	ret.Header = redactHeader(res.Header)
+	if res.Request != nil {
+		req := *res.Request
+		u := *res.Request.URL
+		u.RawQuery = redact(u.RawQuery)
+		req.URL = &u
+		ret.Request = &req
+	}
+	return &ret
+}
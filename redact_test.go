@@ -0,0 +1,88 @@
+package alphavantage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const secretKey = "SECRETKEY123"
+
+// errorTransport fails every request like a broken connection
+type errorTransport struct{}
+
+func (t *errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("connection reset by peer")
+}
+
+func TestDo_redact(t *testing.T) {
+	broken := newTransport(secretKey)
+	broken.originalTransport = &errorTransport{}
+
+	tests := []struct {
+		name   string
+		client *http.Client
+	}{
+		// TODO: Add test cases.
+		{"url.Error", &http.Client{Transport: broken}},
+		{"Body", clientTest(secretKey, "<html>Bad Request https://www.alphavantage.co/query?function=TIME_SERIES_DAILY&apikey="+secretKey+"</html>", http.StatusBadRequest)},
+		{"Escaped Body", clientTest(secretKey, "redirect=https%3A%2F%2Fwww.alphavantage.co%2Fquery%3Fapikey%3D"+secretKey, http.StatusBadGateway)},
+		{"Note", clientTest(secretKey, noteBody, http.StatusOK)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avTest, _ := New(tt.client)
+
+			var logs []string
+			avTest.Use(&Middleware{
+				AfterResponse: func(ctx context.Context, info *RequestInfo, res *http.Response) {
+					logs = append(logs, fmt.Sprintf("%+v %s", info, res.Request.URL))
+				},
+				OnError: func(ctx context.Context, info *RequestInfo, err error) {
+					logs = append(logs, fmt.Sprintf("%+v %v", info, err))
+				},
+			})
+
+			_, err := avTest.TimeSeries.Daily("symbol").Do()
+			if err == nil {
+				t.Fatalf("TimeSeriesDailyCall.Do() error = nil, want error")
+			}
+			if strings.Contains(err.Error(), secretKey) || strings.Contains(fmt.Sprintf("%+v", err), secretKey) {
+				t.Errorf("TimeSeriesDailyCall.Do() error = %v, want no apikey", err)
+			}
+			var e *Error
+			if errors.As(err, &e) && (strings.Contains(e.Body, secretKey) || strings.Contains(fmt.Sprint(e.Header), secretKey)) {
+				t.Errorf("TimeSeriesDailyCall.Do() Error = %#v, want no apikey", e)
+			}
+			for _, l := range logs {
+				if strings.Contains(l, secretKey) {
+					t.Errorf("Middleware got %s, want no apikey", l)
+				}
+			}
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		// TODO: Add test cases.
+		{"query", "https://www.alphavantage.co/query?apikey=key&function=SECTOR", "https://www.alphavantage.co/query?apikey=REDACTED&function=SECTOR"},
+		{"last", `Get "https://www.alphavantage.co/query?function=SECTOR&apikey=key": EOF`, `Get "https://www.alphavantage.co/query?function=SECTOR&apikey=REDACTED": EOF`},
+		{"escaped", "q%3Fapikey%3Dkey%26function", "q%3Fapikey%3DREDACTED%26function"},
+		{"none", "timestamp,open", "timestamp,open"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redact(tt.s); got != tt.want {
+				t.Errorf("redact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
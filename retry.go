@@ -0,0 +1,124 @@
+package alphavantage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy retries throttled (ErrRateLimited) and 5xx responses with exponential backoff
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries int
+	// Backoff is the wait before the first retry, doubled for every next retry.
+	// By default, Backoff is 15 seconds.
+	Backoff time.Duration
+	// MaxBackoff caps the wait between two attempts. By default, MaxBackoff is 2 minutes.
+	MaxBackoff time.Duration
+}
+
+// backoff returns the wait before the retry-th retry, starting at 1
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d, max := p.Backoff, p.MaxBackoff
+	if d <= 0 {
+		d = 15 * time.Second
+	}
+	if max <= 0 {
+		max = 2 * time.Minute
+	}
+
+	for i := 1; i < retry && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+// retryable reports whether res should be retried
+// The body of res is replaced, so it can still be decoded afterwards.
+func (p *RetryPolicy) retryable(res *http.Response) (bool, error) {
+	if res.StatusCode >= 500 {
+		return true, nil
+	}
+
+	errReply, err := peekErrorReply(res)
+	if err != nil {
+		return false, err
+	}
+	return errReply != nil && errReply.err() == ErrRateLimited, nil
+}
+
+// peekErrorReply returns the json envelope of an Error Message or Note in the body of res, nil if there is none.
+// The body of res is replaced, so it can still be decoded afterwards.
+// Only a body starting with '{' is read completely, csv bodies are left streaming.
+func peekErrorReply(res *http.Response) (*errorReply, error) {
+	if res.Body == nil {
+		return nil, nil
+	}
+
+	br := bufio.NewReader(res.Body)
+	body := struct {
+		io.Reader
+		io.Closer
+	}{br, res.Body}
+	res.Body = body
+
+	for {
+		b, err := br.Peek(1)
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "bufio.Reader.Peek")
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			br.ReadByte()
+			continue
+		}
+		if b[0] != '{' {
+			return nil, nil
+		}
+		break
+	}
+
+	b, err := ioutil.ReadAll(br)
+	if err != nil {
+		return nil, errors.Wrapf(err, "ioutil.ReadAll")
+	}
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{bytes.NewReader(b), body.Closer}
+
+	errReply := &errorReply{}
+	if err := json.Unmarshal(b, errReply); err != nil || errReply.String() == "" {
+		return nil, nil
+	}
+	return errReply, nil
+}
+
+// sleep waits d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,148 @@
+package alphavantage
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	noteBody        = `{"Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 500 calls per day."}`
+	errorBody       = `{"Error Message": "Invalid API call. Please retry or visit the documentation (https://www.alphavantage.co/documentation/) for TIME_SERIES_DAILY."}`
+	informationBody = `{"Information": "Thank you for using Alpha Vantage! This is a premium endpoint."}`
+	dailyBody       = `timestamp,open,high,low,close,volume
+2020-03-25,148.9800,149.1000,146.1600,146.8600,2666656`
+)
+
+type sequenceResponse struct {
+	body       string
+	statusCode int
+}
+
+// sequenceTransport returns the responses in order, repeating the last one
+type sequenceTransport struct {
+	responses []sequenceResponse
+	calls     int
+}
+
+func (t *sequenceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := t.responses[len(t.responses)-1]
+	if t.calls < len(t.responses) {
+		r = t.responses[t.calls]
+	}
+	t.calls++
+
+	var res http.Response
+	res.StatusCode = r.statusCode
+	res.Body = ioutil.NopCloser(strings.NewReader(r.body))
+	res.Header = http.Header{}
+	res.Request = req
+
+	return &res, nil
+}
+
+func clientSequence(key string, responses ...sequenceResponse) (*http.Client, *sequenceTransport) {
+	st := &sequenceTransport{responses: responses}
+	transport := newTransport(key)
+	transport.originalTransport = st
+
+	return &http.Client{Transport: transport}, st
+}
+
+func TestError_Is(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		statusCode int
+		want       error
+	}{
+		// TODO: Add test cases.
+		{"Note", noteBody, http.StatusOK, ErrRateLimited},
+		{"Error Message", errorBody, http.StatusOK, ErrInvalidAPICall},
+		{"Information", informationBody, http.StatusOK, ErrInformation},
+		{"Not Found", errorBody, http.StatusNotFound, ErrInvalidAPICall},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avTest, _ := New(clientTest("key", tt.body, tt.statusCode))
+			_, err := avTest.TimeSeries.Daily("symbol").Do()
+			if !errors.Is(err, tt.want) {
+				t.Errorf("TimeSeriesDailyCall.Do() error = %v, want %v", err, tt.want)
+			}
+			var e *Error
+			if !errors.As(err, &e) || e.Code != tt.statusCode {
+				t.Errorf("TimeSeriesDailyCall.Do() error = %#v, want *Error with code %d", err, tt.statusCode)
+			}
+		})
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	avTest, _ := New(clientTest("key", "<html>Bad Gateway</html>", http.StatusBadGateway))
+	_, err := avTest.TimeSeries.Daily("symbol").Do()
+	var e *Error
+	if !errors.As(err, &e) || e.Code != http.StatusBadGateway || e.Body != "<html>Bad Gateway</html>" {
+		t.Errorf("TimeSeriesDailyCall.Do() error = %#v, want *Error with code %d", err, http.StatusBadGateway)
+	}
+}
+
+func TestService_RetryPolicy(t *testing.T) {
+	policy := &RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}
+
+	tests := []struct {
+		name      string
+		policy    *RetryPolicy
+		responses []sequenceResponse
+		wantCalls int
+		wantErr   error
+	}{
+		// TODO: Add test cases.
+		{"Note", policy, []sequenceResponse{{noteBody, http.StatusOK}, {dailyBody, http.StatusOK}}, 2, nil},
+		{"5xx", policy, []sequenceResponse{{"", http.StatusServiceUnavailable}, {noteBody, http.StatusOK}, {dailyBody, http.StatusOK}}, 3, nil},
+		{"Exhausted", policy, []sequenceResponse{{noteBody, http.StatusOK}}, 3, ErrRateLimited},
+		{"Error Message", policy, []sequenceResponse{{errorBody, http.StatusOK}}, 1, ErrInvalidAPICall},
+		{"No Policy", nil, []sequenceResponse{{noteBody, http.StatusOK}, {dailyBody, http.StatusOK}}, 1, ErrRateLimited},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, st := clientSequence("key", tt.responses...)
+			avTest, _ := New(client)
+			avTest.RetryPolicy = tt.policy
+
+			got, err := avTest.TimeSeries.Daily("symbol").Do()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TimeSeriesDailyCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(got.TimeSeries) != 1 {
+				t.Errorf("TimeSeriesDailyCall.Do() = %v, want 1 TimeSeries", got.TimeSeries)
+			}
+			if st.calls != tt.wantCalls {
+				t.Errorf("TimeSeriesDailyCall.Do() calls = %d, want %d", st.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *RetryPolicy
+		retry  int
+		want   time.Duration
+	}{
+		// TODO: Add test cases.
+		{"default", &RetryPolicy{}, 1, 15 * time.Second},
+		{"double", &RetryPolicy{Backoff: time.Second}, 3, 4 * time.Second},
+		{"max", &RetryPolicy{Backoff: time.Second, MaxBackoff: 3 * time.Second}, 3, 3 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.backoff(tt.retry); got != tt.want {
+				t.Errorf("RetryPolicy.backoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,12 @@
 package alphavantage
 
-// To do
-// https://www.alphavantage.co/documentation/#sector-information
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 // NewSectorPerformancesService https://www.alphavantage.co/documentation/#sector-information
 // This API returns the realtime and historical sector performances calculated from S&P500 incumbents.
@@ -15,3 +20,48 @@ func NewSectorPerformancesService(s *Service) *SectorPerformancesService {
 type SectorPerformancesService struct {
 	s *Service
 }
+
+// Sector https://www.alphavantage.co/documentation/#sector-information
+// This API returns the realtime and historical sector performances calculated from S&P500 incumbents.
+// datatype fixed to json
+func (r *SectorPerformancesService) Sector() *SectorPerformancesCall {
+	c := &SectorPerformancesCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", "SECTOR")
+	return c
+}
+
+// SectorPerformancesCall https://www.alphavantage.co/documentation/#sector-information
+// This API returns the realtime and historical sector performances calculated from S&P500 incumbents.
+// datatype fixed to json
+type SectorPerformancesCall struct {
+	DefaultCall
+}
+
+// Do send request
+func (c *SectorPerformancesCall) Do() (*SectorPerformance, error) {
+	ret := &SectorPerformance{}
+	sr, err := c.doJSON(ret)
+	if err != nil {
+		return nil, err
+	}
+	ret.ServerResponse = sr
+
+	// Last Refreshed looks like "2020-04-02 14:38:58 US/Eastern"
+	refreshed := ret.MetaData.LastRefreshedRaw
+	if i := strings.LastIndex(refreshed, " "); i >= 0 {
+		if loc, err := time.LoadLocation(refreshed[i+1:]); err == nil {
+			refreshed = refreshed[:i]
+			if err := ret.MetaData.LastRefreshed.UnmarshalCSV([]byte(refreshed)); err != nil {
+				return nil, errors.Wrapf(err, "Time.UnmarshalCSV")
+			}
+			ret.MetaData.LastRefreshed = ret.MetaData.LastRefreshed.in(loc)
+		}
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,103 @@
+package alphavantage
+
+import (
+	"sort"
+)
+
+// SectorHorizon the time horizon of a sector performance ranking
+type SectorHorizon string
+
+// Sector Performances
+const (
+	SectorHorizonRealTime   SectorHorizon = "Rank A: Real-Time Performance"
+	SectorHorizonOneDay     SectorHorizon = "Rank B: 1 Day Performance"
+	SectorHorizonFiveDay    SectorHorizon = "Rank C: 5 Day Performance"
+	SectorHorizonOneMonth   SectorHorizon = "Rank D: 1 Month Performance"
+	SectorHorizonThreeMonth SectorHorizon = "Rank E: 3 Month Performance"
+	SectorHorizonYearToDate SectorHorizon = "Rank F: Year-to-Date (YTD) Performance"
+	SectorHorizonOneYear    SectorHorizon = "Rank G: 1 Year Performance"
+	SectorHorizonThreeYear  SectorHorizon = "Rank H: 3 Year Performance"
+	SectorHorizonFiveYear   SectorHorizon = "Rank I: 5 Year Performance"
+	SectorHorizonTenYear    SectorHorizon = "Rank J: 10 Year Performance"
+)
+
+// SectorMetaData Meta Data of sector performances
+type SectorMetaData struct {
+	Information      string `json:"Information"`
+	LastRefreshedRaw string `json:"Last Refreshed"`
+
+	// LastRefreshed is parsed from LastRefreshedRaw in its own time zone
+	LastRefreshed Time `json:"-"`
+}
+
+// SectorPerformance sector performances for every horizon, keyed by sector name
+type SectorPerformance struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+
+	MetaData SectorMetaData `json:"Meta Data"`
+
+	RealTime   map[string]Percent `json:"Rank A: Real-Time Performance"`
+	OneDay     map[string]Percent `json:"Rank B: 1 Day Performance"`
+	FiveDay    map[string]Percent `json:"Rank C: 5 Day Performance"`
+	OneMonth   map[string]Percent `json:"Rank D: 1 Month Performance"`
+	ThreeMonth map[string]Percent `json:"Rank E: 3 Month Performance"`
+	YearToDate map[string]Percent `json:"Rank F: Year-to-Date (YTD) Performance"`
+	OneYear    map[string]Percent `json:"Rank G: 1 Year Performance"`
+	ThreeYear  map[string]Percent `json:"Rank H: 3 Year Performance"`
+	FiveYear   map[string]Percent `json:"Rank I: 5 Year Performance"`
+	TenYear    map[string]Percent `json:"Rank J: 10 Year Performance"`
+}
+
+// Performance returns the performance of each sector for horizon h
+func (s *SectorPerformance) Performance(h SectorHorizon) map[string]Percent {
+	switch h {
+	case SectorHorizonRealTime:
+		return s.RealTime
+	case SectorHorizonOneDay:
+		return s.OneDay
+	case SectorHorizonFiveDay:
+		return s.FiveDay
+	case SectorHorizonOneMonth:
+		return s.OneMonth
+	case SectorHorizonThreeMonth:
+		return s.ThreeMonth
+	case SectorHorizonYearToDate:
+		return s.YearToDate
+	case SectorHorizonOneYear:
+		return s.OneYear
+	case SectorHorizonThreeYear:
+		return s.ThreeYear
+	case SectorHorizonFiveYear:
+		return s.FiveYear
+	case SectorHorizonTenYear:
+		return s.TenYear
+	}
+
+	return nil
+}
+
+// SectorRank performance of one sector
+type SectorRank struct {
+	Sector      string
+	Performance Percent
+}
+
+// Ranking returns the sectors for horizon h, best performance first
+func (s *SectorPerformance) Ranking(h SectorHorizon) []*SectorRank {
+	performance := s.Performance(h)
+
+	ranks := make([]*SectorRank, 0, len(performance))
+	for sector, p := range performance {
+		ranks = append(ranks, &SectorRank{Sector: sector, Performance: p})
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		if ranks[i].Performance != ranks[j].Performance {
+			return ranks[i].Performance > ranks[j].Performance
+		}
+		return ranks[i].Sector < ranks[j].Sector
+	})
+
+	return ranks
+}
@@ -0,0 +1,131 @@
+package alphavantage
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const sectorBody = `{
+    "Meta Data": {
+        "Information": "US Sector Performance (realtime & historical)",
+        "Last Refreshed": "2020-04-02 14:38:58 US/Eastern"
+    },
+    "Rank A: Real-Time Performance": {
+        "Energy": "3.81%",
+        "Utilities": "-0.49%",
+        "Information Technology": "1.26%"
+    },
+    "Rank B: 1 Day Performance": {
+        "Energy": "-6.27%",
+        "Utilities": "-5.07%",
+        "Information Technology": "-5.00%"
+    },
+    "Rank J: 10 Year Performance": {
+        "Information Technology": "262.51%",
+        "Utilities": "56.92%",
+        "Energy": "-58.97%"
+    }
+}`
+
+func TestSectorPerformancesCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *SectorPerformancesCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"normal", NewSectorPerformancesService(avTest).Sector(), "https://www.alphavantage.co/query?apikey=key&function=SECTOR", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SectorPerformancesCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SectorPerformancesCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSectorPerformancesCall_Do(t *testing.T) {
+	client := clientTest("key", sectorBody, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *SectorPerformancesCall
+		want    *SectorPerformance
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewSectorPerformancesService(avTest).Sector(), &SectorPerformance{
+			ServerResponse: ServerResponse{HTTPStatusCode: 200, Header: http.Header{}},
+			MetaData: SectorMetaData{
+				Information:      "US Sector Performance (realtime & historical)",
+				LastRefreshedRaw: "2020-04-02 14:38:58 US/Eastern",
+				LastRefreshed:    Time(time.Date(2020, time.April, 2, 14, 38, 58, 0, eastern)),
+			},
+			RealTime: map[string]Percent{"Energy": 3.81, "Utilities": -0.49, "Information Technology": 1.26},
+			OneDay:   map[string]Percent{"Energy": -6.27, "Utilities": -5.07, "Information Technology": -5},
+			TenYear:  map[string]Percent{"Energy": -58.97, "Utilities": 56.92, "Information Technology": 262.51},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SectorPerformancesCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SectorPerformancesCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSectorPerformance_Ranking(t *testing.T) {
+	client := clientTest("key", sectorBody, http.StatusOK)
+	avTest, _ := New(client)
+	sp, err := NewSectorPerformancesService(avTest).Sector().Do()
+	if err != nil {
+		t.Fatalf("SectorPerformancesCall.Do() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		h    SectorHorizon
+		want []*SectorRank
+	}{
+		// TODO: Add test cases.
+		{"RealTime", SectorHorizonRealTime, []*SectorRank{
+			{Sector: "Energy", Performance: 3.81},
+			{Sector: "Information Technology", Performance: 1.26},
+			{Sector: "Utilities", Performance: -0.49},
+		}},
+		{"TenYear", SectorHorizonTenYear, []*SectorRank{
+			{Sector: "Information Technology", Performance: 262.51},
+			{Sector: "Utilities", Performance: 56.92},
+			{Sector: "Energy", Performance: -58.97},
+		}},
+		{"Missing", SectorHorizonFiveYear, []*SectorRank{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sp.Ranking(tt.h); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SectorPerformance.Ranking() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package alphavantage
 
 import (
-	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -26,9 +25,81 @@ type TimeSeriesService struct {
 	s *Service
 }
 
+// doTimeSeries sends the request and decodes the TimeSeriesList by datatype
+func (c *DefaultCall) doTimeSeries() (*TimeSeriesList, error) {
+	if c.urlParams.Get("datatype") == DatatypeJSON {
+		ret := &TimeSeriesList{Meta: newMeta(c.urlParams, timeZone)}
+		sr, err := c.do(func(res *http.Response) error {
+			return errors.Wrapf(decodeTimeSeriesJSON(ret, res), "decodeTimeSeriesJSON")
+		})
+		if err != nil {
+			return nil, err
+		}
+		ret.ServerResponse = sr
+
+		return ret, nil
+	}
+
+	target := new([]*TimeSeries)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &TimeSeriesList{
+		ServerResponse: sr,
+		Meta:           newMeta(c.urlParams, timeZone),
+		TimeSeries:     *target,
+	}
+
+	return ret, nil
+}
+
+// doTimeSeriesStream sends the request with datatype csv and calls fn with every TimeSeries
+// while decoding the body, the returned TimeSeriesList has no TimeSeries
+func (c *DefaultCall) doTimeSeriesStream(fn func(ts *TimeSeries) error) (*TimeSeriesList, error) {
+	params := make(url.Values, len(c.urlParams))
+	for k, v := range c.urlParams {
+		params[k] = append([]string(nil), v...)
+	}
+	params.Set("datatype", DatatypeCSV)
+
+	// caching reads the whole body before the first row is decoded
+	sr, err := c.doParams(params, true, func(res *http.Response) error {
+		dec, err := newResponseCSVDecoder(res)
+		if err != nil || dec == nil {
+			return err
+		}
+
+		for {
+			ts := &TimeSeries{}
+			err := dec.Decode(ts)
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return errors.Wrapf(err, "csvutil.Decoder.Decode")
+			}
+			if err := fn(ts); err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &TimeSeriesList{
+		ServerResponse: sr,
+		Meta:           newMeta(params, timeZone),
+	}
+
+	return ret, nil
+}
+
 // Intraday https://www.alphavantage.co/documentation/#intraday
 // This API returns intraday time series (timestamp, open, high, low, close, volume) of the equity specified.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 func (r *TimeSeriesService) Intraday(symbol, interval string) *TimeSeriesIntradayCall {
 	c := &TimeSeriesIntradayCall{
 		DefaultCall: DefaultCall{
@@ -45,7 +116,7 @@ func (r *TimeSeriesService) Intraday(symbol, interval string) *TimeSeriesIntrada
 
 // TimeSeriesIntradayCall https://www.alphavantage.co/documentation/#intraday
 // This API returns intraday time series (timestamp, open, high, low, close, volume) of the equity specified.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 type TimeSeriesIntradayCall struct {
 	DefaultCall
 }
@@ -61,71 +132,33 @@ func (c *TimeSeriesIntradayCall) Outputsize(outputsize string) *TimeSeriesIntrad
 	return c
 }
 
-// func (c *TimeSeriesIntradayCall) Datatype(datatype string) *TimeSeriesIntradayCall {
-// 	c.urlParams.Set("datatype", datatype)
+// Datatype By default, datatype=csv.
+// Strings json and csv are accepted with the following specifications:
+// json returns the time series in JSON format, including Meta Data;
+// csv returns the time series as a CSV (comma separated value) file.
+func (c *TimeSeriesIntradayCall) Datatype(datatype string) *TimeSeriesIntradayCall {
+	c.urlParams.Set("datatype", datatype)
 
-// 	return c
-// }
-
-func (c *TimeSeriesIntradayCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.BasePath)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
-
-	return SendRequest(c.ctx, c.s.client, req)
+	return c
 }
 
 // Do send request
 func (c *TimeSeriesIntradayCall) Do() (*TimeSeriesList, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
-		}
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
-	}
-
-	ret := &TimeSeriesList{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
-		},
-	}
-	target := new([]*TimeSeries)
-	if err := DecodeResponseCSV(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponseCSV")
-	}
-
-	ret.TimeSeries = *target
+	return c.doTimeSeries()
+}
 
-	return ret, nil
+// DoStream send request with datatype csv and calls fn with every TimeSeries, newest first,
+// while reading the response, so a full history is never held in memory.
+// The Service Cache is skipped.
+// The returned TimeSeriesList has no TimeSeries. An error from fn stops reading and is returned.
+func (c *TimeSeriesIntradayCall) DoStream(fn func(ts *TimeSeries) error) (*TimeSeriesList, error) {
+	return c.doTimeSeriesStream(fn)
 }
 
 // Daily https://www.alphavantage.co/documentation/#daily
 // This API returns daily time series (date, daily open, daily high, daily low, daily close, daily volume) of the global equity specified, covering 20+ years of historical data.
 // The most recent data point is the prices and volume information of the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 func (r *TimeSeriesService) Daily(symbol string) *TimeSeriesDailyCall {
 	c := &TimeSeriesDailyCall{
 		DefaultCall: DefaultCall{
@@ -142,7 +175,7 @@ func (r *TimeSeriesService) Daily(symbol string) *TimeSeriesDailyCall {
 // TimeSeriesDailyCall https://www.alphavantage.co/documentation/#daily
 // This API returns daily time series (date, daily open, daily high, daily low, daily close, daily volume) of the global equity specified, covering 20+ years of historical data.
 // The most recent data point is the prices and volume information of the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 type TimeSeriesDailyCall struct {
 	DefaultCall
 }
@@ -158,71 +191,33 @@ func (c *TimeSeriesDailyCall) Outputsize(outputsize string) *TimeSeriesDailyCall
 	return c
 }
 
-// func (c *TimeSeriesDailyCall) Datatype(datatype string) *TimeSeriesDailyCall {
-// 	c.urlParams.Set("datatype", datatype)
-
-// 	return c
-// }
-
-func (c *TimeSeriesDailyCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.BasePath)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
+// Datatype By default, datatype=csv.
+// Strings json and csv are accepted with the following specifications:
+// json returns the time series in JSON format, including Meta Data;
+// csv returns the time series as a CSV (comma separated value) file.
+func (c *TimeSeriesDailyCall) Datatype(datatype string) *TimeSeriesDailyCall {
+	c.urlParams.Set("datatype", datatype)
 
-	return SendRequest(c.ctx, c.s.client, req)
+	return c
 }
 
 // Do send request
 func (c *TimeSeriesDailyCall) Do() (*TimeSeriesList, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
-		}
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
-	}
-
-	ret := &TimeSeriesList{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
-		},
-	}
-	target := new([]*TimeSeries)
-	if err := DecodeResponseCSV(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponseCSV")
-	}
-
-	ret.TimeSeries = *target
+	return c.doTimeSeries()
+}
 
-	return ret, nil
+// DoStream send request with datatype csv and calls fn with every TimeSeries, newest first,
+// while reading the response, so a full history is never held in memory.
+// The Service Cache is skipped.
+// The returned TimeSeriesList has no TimeSeries. An error from fn stops reading and is returned.
+func (c *TimeSeriesDailyCall) DoStream(fn func(ts *TimeSeries) error) (*TimeSeriesList, error) {
+	return c.doTimeSeriesStream(fn)
 }
 
 // DailyAdj https://www.alphavantage.co/documentation/#dailyadj
 // This API returns daily time series (date, daily open, daily high, daily low, daily close, daily volume, daily adjusted close, and split/dividend events) of the global equity specified, covering 20+ years of historical data.
 // The most recent data point is the prices and volume information of the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 func (r *TimeSeriesService) DailyAdj(symbol string) *TimeSeriesDailyAdjCall {
 	c := &TimeSeriesDailyAdjCall{
 		DefaultCall: DefaultCall{
@@ -239,7 +234,7 @@ func (r *TimeSeriesService) DailyAdj(symbol string) *TimeSeriesDailyAdjCall {
 // TimeSeriesDailyAdjCall https://www.alphavantage.co/documentation/#DailyAdj
 // This API returns daily time series (date, daily open, daily high, daily low, daily close, daily volume, daily adjusted close, and split/dividend events) of the global equity specified, covering 20+ years of historical data.
 // The most recent data point is the prices and volume information of the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 type TimeSeriesDailyAdjCall struct {
 	DefaultCall
 }
@@ -255,71 +250,33 @@ func (c *TimeSeriesDailyAdjCall) Outputsize(outputsize string) *TimeSeriesDailyA
 	return c
 }
 
-// func (c *TimeSeriesDailyAdjCall) Datatype(datatype string) *TimeSeriesDailyAdjCall {
-// 	c.urlParams.Set("datatype", datatype)
+// Datatype By default, datatype=csv.
+// Strings json and csv are accepted with the following specifications:
+// json returns the time series in JSON format, including Meta Data;
+// csv returns the time series as a CSV (comma separated value) file.
+func (c *TimeSeriesDailyAdjCall) Datatype(datatype string) *TimeSeriesDailyAdjCall {
+	c.urlParams.Set("datatype", datatype)
 
-// 	return c
-// }
-
-func (c *TimeSeriesDailyAdjCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.BasePath)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
-
-	return SendRequest(c.ctx, c.s.client, req)
+	return c
 }
 
 // Do send request
 func (c *TimeSeriesDailyAdjCall) Do() (*TimeSeriesList, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
-		}
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
-	}
-
-	ret := &TimeSeriesList{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
-		},
-	}
-	target := new([]*TimeSeries)
-	if err := DecodeResponseCSV(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponseCSV")
-	}
-
-	ret.TimeSeries = *target
+	return c.doTimeSeries()
+}
 
-	return ret, nil
+// DoStream send request with datatype csv and calls fn with every TimeSeries, newest first,
+// while reading the response, so a full history is never held in memory.
+// The Service Cache is skipped.
+// The returned TimeSeriesList has no TimeSeries. An error from fn stops reading and is returned.
+func (c *TimeSeriesDailyAdjCall) DoStream(fn func(ts *TimeSeries) error) (*TimeSeriesList, error) {
+	return c.doTimeSeriesStream(fn)
 }
 
 // Weekly https://www.alphavantage.co/documentation/#weekly
 // This API returns weekly time series (last trading day of each week, weekly open, weekly high, weekly low, weekly close, weekly volume) of the global equity specified, covering 20+ years of historical data.
 // The latest data point is the prices and volume information for the week (or partial week) that contains the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 func (r *TimeSeriesService) Weekly(symbol string) *TimeSeriesWeeklyCall {
 	c := &TimeSeriesWeeklyCall{
 		DefaultCall: DefaultCall{
@@ -336,76 +293,38 @@ func (r *TimeSeriesService) Weekly(symbol string) *TimeSeriesWeeklyCall {
 // TimeSeriesWeeklyCall https://www.alphavantage.co/documentation/#weekly
 // This API returns weekly time series (last trading day of each week, weekly open, weekly high, weekly low, weekly close, weekly volume) of the global equity specified, covering 20+ years of historical data.
 // The latest data point is the prices and volume information for the week (or partial week) that contains the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 type TimeSeriesWeeklyCall struct {
 	DefaultCall
 }
 
-// func (c *TimeSeriesWeeklyCall) Datatype(datatype string) *TimeSeriesWeeklyCall {
-// 	c.urlParams.Set("datatype", datatype)
-
-// 	return c
-// }
+// Datatype By default, datatype=csv.
+// Strings json and csv are accepted with the following specifications:
+// json returns the time series in JSON format, including Meta Data;
+// csv returns the time series as a CSV (comma separated value) file.
+func (c *TimeSeriesWeeklyCall) Datatype(datatype string) *TimeSeriesWeeklyCall {
+	c.urlParams.Set("datatype", datatype)
 
-func (c *TimeSeriesWeeklyCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.BasePath)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
-
-	return SendRequest(c.ctx, c.s.client, req)
+	return c
 }
 
 // Do send request
 func (c *TimeSeriesWeeklyCall) Do() (*TimeSeriesList, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
-		}
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
-	}
-
-	ret := &TimeSeriesList{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
-		},
-	}
-	target := new([]*TimeSeries)
-	if err := DecodeResponseCSV(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponseCSV")
-	}
-
-	ret.TimeSeries = *target
+	return c.doTimeSeries()
+}
 
-	return ret, nil
+// DoStream send request with datatype csv and calls fn with every TimeSeries, newest first,
+// while reading the response, so a full history is never held in memory.
+// The Service Cache is skipped.
+// The returned TimeSeriesList has no TimeSeries. An error from fn stops reading and is returned.
+func (c *TimeSeriesWeeklyCall) DoStream(fn func(ts *TimeSeries) error) (*TimeSeriesList, error) {
+	return c.doTimeSeriesStream(fn)
 }
 
 // WeeklyAdj https://www.alphavantage.co/documentation/#weeklyadj
 // This API returns weekly adjusted time series (last trading day of each week, weekly open, weekly high, weekly low, weekly close, weekly adjusted close, weekly volume, weekly dividend) of the global equity specified, covering 20+ years of historical data.
 // The latest data point is the prices and volume information for the week (or partial week) that contains the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 func (r *TimeSeriesService) WeeklyAdj(symbol string) *TimeSeriesWeeklyAdjCall {
 	c := &TimeSeriesWeeklyAdjCall{
 		DefaultCall: DefaultCall{
@@ -422,76 +341,38 @@ func (r *TimeSeriesService) WeeklyAdj(symbol string) *TimeSeriesWeeklyAdjCall {
 // TimeSeriesWeeklyAdjCall https://www.alphavantage.co/documentation/#weeklyadj
 // This API returns weekly adjusted time series (last trading day of each week, weekly open, weekly high, weekly low, weekly close, weekly adjusted close, weekly volume, weekly dividend) of the global equity specified, covering 20+ years of historical data.
 // The latest data point is the prices and volume information for the week (or partial week) that contains the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 type TimeSeriesWeeklyAdjCall struct {
 	DefaultCall
 }
 
-// func (c *TimeSeriesWeeklyAdjCall) Datatype(datatype string) *TimeSeriesWeeklyAdjCall {
-// 	c.urlParams.Set("datatype", datatype)
+// Datatype By default, datatype=csv.
+// Strings json and csv are accepted with the following specifications:
+// json returns the time series in JSON format, including Meta Data;
+// csv returns the time series as a CSV (comma separated value) file.
+func (c *TimeSeriesWeeklyAdjCall) Datatype(datatype string) *TimeSeriesWeeklyAdjCall {
+	c.urlParams.Set("datatype", datatype)
 
-// 	return c
-// }
-
-func (c *TimeSeriesWeeklyAdjCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.BasePath)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
-
-	return SendRequest(c.ctx, c.s.client, req)
+	return c
 }
 
 // Do send request
 func (c *TimeSeriesWeeklyAdjCall) Do() (*TimeSeriesList, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
-		}
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
-	}
-
-	ret := &TimeSeriesList{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
-		},
-	}
-	target := new([]*TimeSeries)
-	if err := DecodeResponseCSV(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponseCSV")
-	}
-
-	ret.TimeSeries = *target
+	return c.doTimeSeries()
+}
 
-	return ret, nil
+// DoStream send request with datatype csv and calls fn with every TimeSeries, newest first,
+// while reading the response, so a full history is never held in memory.
+// The Service Cache is skipped.
+// The returned TimeSeriesList has no TimeSeries. An error from fn stops reading and is returned.
+func (c *TimeSeriesWeeklyAdjCall) DoStream(fn func(ts *TimeSeries) error) (*TimeSeriesList, error) {
+	return c.doTimeSeriesStream(fn)
 }
 
 // Monthly https://www.alphavantage.co/documentation/#monthly
 // This API returns monthly time series (last trading day of each month, monthly open, monthly high, monthly low, monthly close, monthly volume) of the global equity specified, covering 20+ years of historical data.
 // The latest data point is the prices and volume information for the month (or partial month) that contains the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 func (r *TimeSeriesService) Monthly(symbol string) *TimeSeriesMonthlyCall {
 	c := &TimeSeriesMonthlyCall{
 		DefaultCall: DefaultCall{
@@ -508,76 +389,38 @@ func (r *TimeSeriesService) Monthly(symbol string) *TimeSeriesMonthlyCall {
 // TimeSeriesMonthlyCall https://www.alphavantage.co/documentation/#monthly
 // This API returns monthly time series (last trading day of each month, monthly open, monthly high, monthly low, monthly close, monthly volume) of the global equity specified, covering 20+ years of historical data.
 // The latest data point is the prices and volume information for the month (or partial month) that contains the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 type TimeSeriesMonthlyCall struct {
 	DefaultCall
 }
 
-// func (c *TimeSeriesMonthlyCall) Datatype(datatype string) *TimeSeriesMonthlyCall {
-// 	c.urlParams.Set("datatype", datatype)
-
-// 	return c
-// }
-
-func (c *TimeSeriesMonthlyCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.BasePath)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
+// Datatype By default, datatype=csv.
+// Strings json and csv are accepted with the following specifications:
+// json returns the time series in JSON format, including Meta Data;
+// csv returns the time series as a CSV (comma separated value) file.
+func (c *TimeSeriesMonthlyCall) Datatype(datatype string) *TimeSeriesMonthlyCall {
+	c.urlParams.Set("datatype", datatype)
 
-	return SendRequest(c.ctx, c.s.client, req)
+	return c
 }
 
 // Do send request
 func (c *TimeSeriesMonthlyCall) Do() (*TimeSeriesList, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
-		}
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
-	}
-
-	ret := &TimeSeriesList{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
-		},
-	}
-	target := new([]*TimeSeries)
-	if err := DecodeResponseCSV(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponseCSV")
-	}
-
-	ret.TimeSeries = *target
+	return c.doTimeSeries()
+}
 
-	return ret, nil
+// DoStream send request with datatype csv and calls fn with every TimeSeries, newest first,
+// while reading the response, so a full history is never held in memory.
+// The Service Cache is skipped.
+// The returned TimeSeriesList has no TimeSeries. An error from fn stops reading and is returned.
+func (c *TimeSeriesMonthlyCall) DoStream(fn func(ts *TimeSeries) error) (*TimeSeriesList, error) {
+	return c.doTimeSeriesStream(fn)
 }
 
 // MonthlyAdj https://www.alphavantage.co/documentation/#monthlyadj
 // This API returns monthly adjusted time series (last trading day of each month, monthly open, monthly high, monthly low, monthly close, monthly adjusted close, monthly volume, monthly dividend) of the equity specified, covering 20+ years of historical data.
 // The latest data point is the prices and volume information for the month (or partial month) that contains the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 func (r *TimeSeriesService) MonthlyAdj(symbol string) *TimeSeriesMonthlyAdjCall {
 	c := &TimeSeriesMonthlyAdjCall{
 		DefaultCall: DefaultCall{
@@ -594,70 +437,32 @@ func (r *TimeSeriesService) MonthlyAdj(symbol string) *TimeSeriesMonthlyAdjCall
 // TimeSeriesMonthlyAdjCall https://www.alphavantage.co/documentation/#monthlyadj
 // This API returns monthly adjusted time series (last trading day of each month, monthly open, monthly high, monthly low, monthly close, monthly adjusted close, monthly volume, monthly dividend) of the equity specified, covering 20+ years of historical data.
 // The latest data point is the prices and volume information for the month (or partial month) that contains the current trading day, updated realtime.
-// datatype fixed to csv
+// datatype csv by default, json with Meta Data
 type TimeSeriesMonthlyAdjCall struct {
 	DefaultCall
 }
 
-// func (c *TimeSeriesMonthlyAdjCall) Datatype(datatype string) *TimeSeriesMonthlyAdjCall {
-// 	c.urlParams.Set("datatype", datatype)
-
-// 	return c
-// }
-
-func (c *TimeSeriesMonthlyAdjCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.BasePath)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
+// Datatype By default, datatype=csv.
+// Strings json and csv are accepted with the following specifications:
+// json returns the time series in JSON format, including Meta Data;
+// csv returns the time series as a CSV (comma separated value) file.
+func (c *TimeSeriesMonthlyAdjCall) Datatype(datatype string) *TimeSeriesMonthlyAdjCall {
+	c.urlParams.Set("datatype", datatype)
 
-	return SendRequest(c.ctx, c.s.client, req)
+	return c
 }
 
 // Do send request
 func (c *TimeSeriesMonthlyAdjCall) Do() (*TimeSeriesList, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
-		}
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
-	}
-
-	ret := &TimeSeriesList{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
-		},
-	}
-	target := new([]*TimeSeries)
-	if err := DecodeResponseCSV(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponseCSV")
-	}
-
-	ret.TimeSeries = *target
+	return c.doTimeSeries()
+}
 
-	return ret, nil
+// DoStream send request with datatype csv and calls fn with every TimeSeries, newest first,
+// while reading the response, so a full history is never held in memory.
+// The Service Cache is skipped.
+// The returned TimeSeriesList has no TimeSeries. An error from fn stops reading and is returned.
+func (c *TimeSeriesMonthlyAdjCall) DoStream(fn func(ts *TimeSeries) error) (*TimeSeriesList, error) {
+	return c.doTimeSeriesStream(fn)
 }
 
 // QuoteEndpoint https://www.alphavantage.co/documentation/#latestprice
@@ -680,73 +485,23 @@ func (r *TimeSeriesService) QuoteEndpoint(symbol string) *TimeSeriesQuoteEndpoin
 
 // TimeSeriesQuoteEndpointCall https://www.alphavantage.co/documentation/#latestprice
 // A lightweight alternative to the time series APIs, this service returns the latest price and volume information for a security of your choice.
-// datatype fixed to csv
+// datatype fixed to csv, there is no Datatype to decode json
 type TimeSeriesQuoteEndpointCall struct {
 	DefaultCall
 
 	symbol string
 }
 
-// func (c *TimeSeriesQuoteEndpointCall) Datatype(datatype string) *TimeSeriesQuoteEndpointCall {
-// 	c.urlParams.Set("datatype", datatype)
-
-// 	return c
-// }
-
-func (c *TimeSeriesQuoteEndpointCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.BasePath)
-	urls += "?" + c.urlParams.Encode()
-	// fmt.Printf("%s\n", urls)
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
-
-	return SendRequest(c.ctx, c.s.client, req)
-}
-
 // Do send request
 func (c *TimeSeriesQuoteEndpointCall) Do() (*Quote, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
-		}
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
-	}
-
 	target := new([]*Quote)
-	if err := DecodeResponseCSV(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponseCSV")
-	}
-
-	if len((*target)) == 0 {
-		return nil, fmt.Errorf("%s could not be found", c.symbol)
+	sr, err := c.doCSVFound(target, c.symbol, func() bool { return len(*target) != 0 })
+	if err != nil {
+		return nil, err
 	}
 
 	ret := (*target)[0]
-	ret.ServerResponse = ServerResponse{
-		Header:         res.Header,
-		HTTPStatusCode: res.StatusCode,
-	}
+	ret.ServerResponse = sr
 
 	return ret, nil
 }
@@ -769,68 +524,23 @@ func (r *TimeSeriesService) SearchEndpoint(keywords string) *TimeSeriesSearchEnd
 
 // TimeSeriesSearchEndpointCall https://www.alphavantage.co/documentation/#symbolsearch
 // We've got you covered! The Search Endpoint returns the best-matching symbols and market information based on keywords of your choice. The search results also contain match scores that provide you with the full flexibility to develop your own search and filtering logic.
-// datatype fixed to csv
+// datatype fixed to csv, there is no Datatype to decode json
 type TimeSeriesSearchEndpointCall struct {
 	DefaultCall
 }
 
-// func (c *TimeSeriesSearchEndpointCall) Datatype(datatype string) *TimeSeriesSearchEndpointCall {
-// 	c.urlParams.Set("datatype", datatype)
-
-// 	return c
-// }
-
-func (c *TimeSeriesSearchEndpointCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.BasePath)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
-
-	return SendRequest(c.ctx, c.s.client, req)
-}
-
 // Do send request
 func (c *TimeSeriesSearchEndpointCall) Do() (*SearchResultList, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
-		}
-	}
+	target := new([]*SearchResult)
+	sr, err := c.doCSV(target)
 	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
+		return nil, err
 	}
 
 	ret := &SearchResultList{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
-		},
+		ServerResponse: sr,
+		SearchResults:  *target,
 	}
-	target := new([]*SearchResult)
-	if err := DecodeResponseCSV(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponseCSV")
-	}
-
-	ret.SearchResults = *target
 
 	return ret, nil
 }
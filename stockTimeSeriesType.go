@@ -1,6 +1,10 @@
 package alphavantage
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -11,15 +15,31 @@ import (
 // Time redefine time.time for Unmarshal
 type Time time.Time
 
-// UnmarshalCSV process Date
+// UnmarshalCSV process Date in US/Eastern,
+// or in the time zone it ends with, e.g. "2020-04-02 14:38:58 UTC"
 func (t *Time) UnmarshalCSV(data []byte) error {
 	// timeSeriesDateFormats are the expected date formats in time series data
 	timeSeriesDateFormats := []string{
 		"2006-01-02",
 		"2006-01-02 15:04:05",
+		"2006-01-02 15:04",
 	}
 
-	d, err := parseDate(string(data), timeSeriesDateFormats...)
+	s := string(data)
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		panic(err)
+	}
+	// a time zone starts with a letter, unlike the time of day
+	if i := strings.LastIndex(s, " "); i >= 0 && i+1 < len(s) && (s[i+1] < '0' || s[i+1] > '9') {
+		l, err := time.LoadLocation(s[i+1:])
+		if err != nil {
+			return errors.Wrapf(err, "error parsing time zone of %s", s)
+		}
+		s, loc = s[:i], l
+	}
+
+	d, err := parseDate(s, loc, timeSeriesDateFormats...)
 	if err != nil {
 		return errors.Wrapf(err, "error parsing timestamp %s", string(data))
 	}
@@ -28,6 +48,33 @@ func (t *Time) UnmarshalCSV(data []byte) error {
 	return nil
 }
 
+// UnmarshalJSON process Date
+func (t *Time) UnmarshalJSON(data []byte) error {
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return errors.Wrapf(err, "strconv.Unquote")
+	}
+
+	return t.UnmarshalCSV([]byte(s))
+}
+
+// timeInZone parses s in the time zone named zone, in US/Eastern if zone is empty
+func timeInZone(s, zone string) (Time, error) {
+	if zone != "" {
+		s += " " + zone
+	}
+
+	var t Time
+	err := t.UnmarshalCSV([]byte(s))
+	return t, err
+}
+
+// in reinterprets the wall clock of t in loc
+func (t Time) in(loc *time.Location) Time {
+	tt := time.Time(t)
+	return Time(time.Date(tt.Year(), tt.Month(), tt.Day(), tt.Hour(), tt.Minute(), tt.Second(), tt.Nanosecond(), loc))
+}
+
 func (t Time) String() string {
 	return time.Time(t).String()
 }
@@ -53,9 +100,161 @@ type TimeSeriesList struct {
 	// server.
 	ServerResponse `csv:"-"`
 
+	// Meta is filled from the request parameters, and from the Meta Data of a json response
+	Meta *Meta
+
 	TimeSeries []*TimeSeries
 }
 
+// Meta Meta Data of a time series
+type Meta struct {
+	// Information and LastRefreshed are only in the Meta Data of a json response
+	Information   string
+	Symbol        string
+	Function      string
+	LastRefreshed Time
+	Interval      string
+	OutputSize    string
+	// Adjusted is true for the *_ADJUSTED functions
+	Adjusted bool
+	// TimeZone is the time zone of the timestamps
+	TimeZone string
+}
+
+// newMeta returns the Meta of the request parameters, whose timestamps are in zone
+func newMeta(params url.Values, zone string) *Meta {
+	function := params.Get("function")
+	return &Meta{
+		Symbol:     params.Get("symbol"),
+		Function:   function,
+		Interval:   params.Get("interval"),
+		OutputSize: params.Get("outputsize"),
+		Adjusted:   strings.HasSuffix(function, "_ADJUSTED"),
+		TimeZone:   zone,
+	}
+}
+
+// jsonFieldName strips the ordinal of a json field name, e.g. "1. open" to "open"
+func jsonFieldName(name string) string {
+	if i := strings.Index(name, ". "); i >= 0 {
+		return name[i+2:]
+	}
+	return name
+}
+
+// decodeTimeSeriesJSON decodes the json body of res, with "Meta Data" and
+// one "Time Series (5min)", "Weekly Adjusted Time Series", ... object, into target.
+// Meta Data overrides the fields of target.Meta it contains.
+// TimeSeries are sorted newest first like csv.
+func decodeTimeSeriesJSON(target *TimeSeriesList, res *http.Response) error {
+	if res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	b, err := readResponseBody(res)
+	if err != nil {
+		return err
+	}
+
+	reply := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &reply); err != nil {
+		return errors.Wrapf(err, "json.Unmarshal")
+	}
+
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return errors.Wrapf(err, "time.LoadLocation")
+	}
+	if raw, ok := reply["Meta Data"]; ok {
+		metaData := map[string]string{}
+		if err := json.Unmarshal(raw, &metaData); err != nil {
+			return errors.Wrapf(err, "json.Unmarshal Meta Data")
+		}
+
+		meta := target.Meta
+		if meta == nil {
+			meta = &Meta{}
+		}
+		for name, v := range metaData {
+			switch jsonFieldName(name) {
+			case "Information":
+				meta.Information = v
+			case "Symbol":
+				meta.Symbol = v
+			case "Last Refreshed":
+				if err := meta.LastRefreshed.UnmarshalCSV([]byte(v)); err != nil {
+					return errors.Wrapf(err, "Time.UnmarshalCSV")
+				}
+			case "Interval":
+				meta.Interval = v
+			case "Output Size":
+				meta.OutputSize = v
+			case "Time Zone":
+				meta.TimeZone = v
+			}
+		}
+		if l, err := time.LoadLocation(meta.TimeZone); meta.TimeZone != "" && err == nil {
+			loc = l
+		}
+		meta.LastRefreshed = meta.LastRefreshed.in(loc)
+		target.Meta = meta
+	}
+
+	for key, raw := range reply {
+		if !strings.Contains(key, "Time Series") {
+			continue
+		}
+
+		series := map[string]map[string]string{}
+		if err := json.Unmarshal(raw, &series); err != nil {
+			return errors.Wrapf(err, "json.Unmarshal %s", key)
+		}
+		for timestamp, values := range series {
+			ts := &TimeSeries{}
+			if err := ts.Time.UnmarshalCSV([]byte(timestamp)); err != nil {
+				return errors.Wrapf(err, "Time.UnmarshalCSV")
+			}
+			ts.Time = ts.Time.in(loc)
+
+			for name, v := range values {
+				var field *float64
+				switch jsonFieldName(name) {
+				case "open":
+					field = &ts.Open
+				case "high":
+					field = &ts.High
+				case "low":
+					field = &ts.Low
+				case "close":
+					field = &ts.Close
+				case "volume":
+					field = &ts.Volume
+				case "adjusted close":
+					field = &ts.AdjustedClose
+				case "dividend amount":
+					field = &ts.DividendAmount
+				case "split coefficient":
+					field = &ts.SplitCoefficient
+				default:
+					continue
+				}
+				f, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					return errors.Wrapf(err, "strconv.ParseFloat")
+				}
+				*field = f
+			}
+			target.TimeSeries = append(target.TimeSeries, ts)
+		}
+	}
+
+	sort.Slice(target.TimeSeries, func(i, j int) bool {
+		return time.Time(target.TimeSeries[i].Time).After(time.Time(target.TimeSeries[j].Time))
+	})
+
+	return nil
+}
+
 // Percent redefine for percent value
 type Percent float64
 
@@ -71,6 +270,16 @@ func (p *Percent) UnmarshalCSV(data []byte) error {
 	return nil
 }
 
+// UnmarshalJSON parse data
+func (p *Percent) UnmarshalJSON(data []byte) error {
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return errors.Wrapf(err, "strconv.Unquote")
+	}
+
+	return p.UnmarshalCSV([]byte(s))
+}
+
 // Quote Quote
 type Quote struct {
 	// ServerResponse contains the HTTP response code and headers from the
@@ -1,6 +1,8 @@
 package alphavantage
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"reflect"
 	"testing"
@@ -83,6 +85,7 @@ func TestTimeSeriesDailyCall_doRequest(t *testing.T) {
 		// TODO: Add test cases.
 		{"normal", NewTimeSeriesService(avTest).Daily("symbol"), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=TIME_SERIES_DAILY&symbol=symbol", false},
 		{"full", NewTimeSeriesService(avTest).Daily("symbol").Outputsize(OutputSizeFull), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=TIME_SERIES_DAILY&outputsize=full&symbol=symbol", false},
+		{"json", NewTimeSeriesService(avTest).Daily("symbol").Datatype(DatatypeJSON), "https://www.alphavantage.co/query?apikey=key&datatype=json&function=TIME_SERIES_DAILY&symbol=symbol", false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -563,3 +566,276 @@ BA,The Boeing Company,Equity,United States,09:30,16:00,UTC-05,USD,1.0000`, http.
 		})
 	}
 }
+
+func TestTimeSeriesCall_DoJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		csv      string
+		json     string
+		do       func(s *TimeSeriesService, datatype string) (*TimeSeriesList, error)
+		wantMeta *Meta
+	}{
+		// TODO: Add test cases.
+		{"Intraday", `timestamp,open,high,low,close,volume
+2020-03-25 16:00:00,148.9800,149.1000,146.1600,146.8600,2666656
+2020-03-25 15:55:00,147.5000,149.0000,147.4000,148.9700,1092817`, `{
+    "Meta Data": {
+        "1. Information": "Intraday (5min) open, high, low, close prices and volume",
+        "2. Symbol": "IBM",
+        "3. Last Refreshed": "2020-03-25 16:00:00",
+        "4. Interval": "5min",
+        "5. Output Size": "Compact",
+        "6. Time Zone": "US/Eastern"
+    },
+    "Time Series (5min)": {
+        "2020-03-25 15:55:00": {
+            "1. open": "147.5000",
+            "2. high": "149.0000",
+            "3. low": "147.4000",
+            "4. close": "148.9700",
+            "5. volume": "1092817"
+        },
+        "2020-03-25 16:00:00": {
+            "1. open": "148.9800",
+            "2. high": "149.1000",
+            "3. low": "146.1600",
+            "4. close": "146.8600",
+            "5. volume": "2666656"
+        }
+    }
+}`, func(s *TimeSeriesService, datatype string) (*TimeSeriesList, error) {
+			return s.Intraday("IBM", TimeSeriesIntervalFiveMinute).Datatype(datatype).Do()
+		}, &Meta{
+			Information: "Intraday (5min) open, high, low, close prices and volume",
+			Symbol:      "IBM",
+			Function:    "TIME_SERIES_INTRADAY",
+			Interval:    "5min",
+			OutputSize:  "Compact",
+			TimeZone:    "US/Eastern",
+		}},
+		{"DailyAdj", `timestamp,open,high,low,close,adjusted_close,volume,dividend_amount,split_coefficient
+2020-03-25,148.9800,149.1000,146.1600,146.8600,146.8600,2666656,0.0000,1.0000
+2020-03-24,142.5000,146.0000,142.0000,145.1000,143.4800,3322221,1.6200,1.0000`, `{
+    "Meta Data": {
+        "1. Information": "Daily Time Series with Splits and Dividend Events",
+        "2. Symbol": "IBM",
+        "3. Last Refreshed": "2020-03-25",
+        "4. Output Size": "Compact",
+        "5. Time Zone": "US/Eastern"
+    },
+    "Time Series (Daily)": {
+        "2020-03-24": {
+            "1. open": "142.5000",
+            "2. high": "146.0000",
+            "3. low": "142.0000",
+            "4. close": "145.1000",
+            "5. adjusted close": "143.4800",
+            "6. volume": "3322221",
+            "7. dividend amount": "1.6200",
+            "8. split coefficient": "1.0000"
+        },
+        "2020-03-25": {
+            "1. open": "148.9800",
+            "2. high": "149.1000",
+            "3. low": "146.1600",
+            "4. close": "146.8600",
+            "5. adjusted close": "146.8600",
+            "6. volume": "2666656",
+            "7. dividend amount": "0.0000",
+            "8. split coefficient": "1.0000"
+        }
+    }
+}`, func(s *TimeSeriesService, datatype string) (*TimeSeriesList, error) {
+			return s.DailyAdj("IBM").Datatype(datatype).Do()
+		}, &Meta{
+			Information: "Daily Time Series with Splits and Dividend Events",
+			Symbol:      "IBM",
+			Function:    "TIME_SERIES_DAILY_ADJUSTED",
+			OutputSize:  "Compact",
+			Adjusted:    true,
+			TimeZone:    "US/Eastern",
+		}},
+		{"WeeklyAdj", `timestamp,open,high,low,close,adjusted_close,volume,dividend_amount
+2020-03-27,148.9800,149.1000,146.1600,146.8600,146.8600,2666656,0.0000`, `{
+    "Meta Data": {
+        "1. Information": "Weekly Adjusted Prices and Volumes",
+        "2. Symbol": "IBM",
+        "3. Last Refreshed": "2020-03-27",
+        "4. Time Zone": "US/Eastern"
+    },
+    "Weekly Adjusted Time Series": {
+        "2020-03-27": {
+            "1. open": "148.9800",
+            "2. high": "149.1000",
+            "3. low": "146.1600",
+            "4. close": "146.8600",
+            "5. adjusted close": "146.8600",
+            "6. volume": "2666656",
+            "7. dividend amount": "0.0000"
+        }
+    }
+}`, func(s *TimeSeriesService, datatype string) (*TimeSeriesList, error) {
+			return s.WeeklyAdj("IBM").Datatype(datatype).Do()
+		}, &Meta{
+			Information: "Weekly Adjusted Prices and Volumes",
+			Symbol:      "IBM",
+			Function:    "TIME_SERIES_WEEKLY_ADJUSTED",
+			Adjusted:    true,
+			TimeZone:    "US/Eastern",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avCSV, _ := New(clientTest("key", tt.csv, http.StatusOK))
+			wantRsp, err := tt.do(avCSV.TimeSeries, DatatypeCSV)
+			if err != nil {
+				t.Fatalf("Do() csv error = %v", err)
+			}
+			avJSON, _ := New(clientTest("key", tt.json, http.StatusOK))
+			rsp, err := tt.do(avJSON.TimeSeries, DatatypeJSON)
+			if err != nil {
+				t.Fatalf("Do() json error = %v", err)
+			}
+
+			if got, want := rsp.TimeSeries, wantRsp.TimeSeries; !reflect.DeepEqual(got, want) {
+				t.Errorf("Do() json = %v, csv %v", got, want)
+			}
+			tt.wantMeta.LastRefreshed = wantRsp.TimeSeries[0].Time
+			if got := rsp.Meta; !reflect.DeepEqual(got, tt.wantMeta) {
+				t.Errorf("Do() Meta = %+v, want %+v", got, tt.wantMeta)
+			}
+		})
+	}
+}
+
+func TestTimeSeriesCall_Meta(t *testing.T) {
+	client := clientTest("key", `timestamp,open,high,low,close,volume
+2020-03-25 16:00:00,148.9800,149.1000,146.1600,146.8600,2666656`, http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name string
+		do   func() (*TimeSeriesList, error)
+		want *Meta
+	}{
+		// TODO: Add test cases.
+		{"Intraday", NewTimeSeriesService(avTest).Intraday("symbol", TimeSeriesIntervalFiveMinute).Outputsize(OutputSizeFull).Do,
+			&Meta{Symbol: "symbol", Function: "TIME_SERIES_INTRADAY", Interval: "5min", OutputSize: "full", TimeZone: "US/Eastern"}},
+		{"MonthlyAdj", NewTimeSeriesService(avTest).MonthlyAdj("symbol").Do,
+			&Meta{Symbol: "symbol", Function: "TIME_SERIES_MONTHLY_ADJUSTED", Adjusted: true, TimeZone: "US/Eastern"}},
+		{"Crypto Intraday", NewDigitalCryptoCurrenciesService(avTest).Intraday("ETH", "USD", TimeSeriesIntervalOneMinute).Do,
+			&Meta{Symbol: "ETH", Function: "CRYPTO_INTRADAY", Interval: "1min", TimeZone: "UTC"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.do()
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			if got := rsp.Meta; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Do() Meta = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeSeriesCall_DoStream(t *testing.T) {
+	body := `timestamp,open,high,low,close,volume
+2020-03-25 16:00:00,148.9800,149.1000,146.1600,146.8600,2666656
+2020-03-25 15:55:00,147.5000,149.0000,147.4000,148.9700,1092817`
+	errStop := errors.New("stop")
+
+	tests := []struct {
+		name      string
+		body      string
+		stopAfter int
+		wantCount int
+		wantErr   error
+	}{
+		// TODO: Add test cases.
+		{"Rows", body, 0, 2, nil},
+		{"Empty", "", 0, 0, nil},
+		{"Stop", body, 1, 1, errStop},
+		{"Note", noteBody, 0, 0, ErrRateLimited},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avTest, _ := New(clientTest("key", tt.body, http.StatusOK))
+			want, err := NewTimeSeriesService(avTest).Intraday("symbol", TimeSeriesIntervalFiveMinute).Do()
+			if err != nil && tt.wantErr == nil {
+				t.Fatalf("TimeSeriesIntradayCall.Do() error = %v", err)
+			}
+
+			var sent string
+			avTest.Use(&Middleware{
+				BeforeRequest: func(ctx context.Context, info *RequestInfo) error {
+					sent = info.Params.Get("datatype")
+					return nil
+				},
+			})
+
+			var got []*TimeSeries
+			c := NewTimeSeriesService(avTest).Intraday("symbol", TimeSeriesIntervalFiveMinute).Datatype(DatatypeJSON)
+			rsp, err := c.DoStream(func(ts *TimeSeries) error {
+				got = append(got, ts)
+				if len(got) == tt.stopAfter {
+					return errStop
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TimeSeriesIntradayCall.DoStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantCount {
+				t.Fatalf("TimeSeriesIntradayCall.DoStream() rows = %d, want %d", len(got), tt.wantCount)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !reflect.DeepEqual(got, want.TimeSeries) && len(got) != 0 {
+				t.Errorf("TimeSeriesIntradayCall.DoStream() = %v, want %v", got, want.TimeSeries)
+			}
+			if rsp.Meta.Interval != TimeSeriesIntervalFiveMinute || rsp.TimeSeries != nil {
+				t.Errorf("TimeSeriesIntradayCall.DoStream() = %+v, want Meta and no TimeSeries", rsp)
+			}
+			if sent != DatatypeCSV {
+				t.Errorf("TimeSeriesIntradayCall.DoStream() datatype = %s, want %s", sent, DatatypeCSV)
+			}
+			// the call is unchanged for a later Do
+			if got := c.urlParams.Get("datatype"); got != DatatypeJSON || c.skipCache {
+				t.Errorf("TimeSeriesIntradayCall datatype = %s, skipCache = %v, want %s and false", got, c.skipCache, DatatypeJSON)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalCSV(t *testing.T) {
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		data    string
+		want    Time
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Eastern", "2020-03-25 16:00:00", Time(time.Date(2020, time.March, 25, 16, 0, 0, 0, eastern)), false},
+		{"Date", "2020-03-25", Time(time.Date(2020, time.March, 25, 0, 0, 0, 0, eastern)), false},
+		{"UTC", "2020-03-08 02:30:00 UTC", Time(time.Date(2020, time.March, 8, 2, 30, 0, 0, time.UTC)), false},
+		{"Time Zone", "2020-04-02 14:38:58 US/Eastern", Time(time.Date(2020, time.April, 2, 14, 38, 58, 0, eastern)), false},
+		{"Unknown Time Zone", "2020-04-02 14:38:58 Nowhere", Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Time
+			err := got.UnmarshalCSV([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Time.UnmarshalCSV() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Time.UnmarshalCSV() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,12 @@
 package alphavantage
 
-// To do
-// https://www.alphavantage.co/documentation/#technical-indicators
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
 
 // NewTechnicalIndicatorsService https://www.alphavantage.co/documentation/#technical-indicators
 // Technical indicator values are updated realtime: the latest data point is derived from the current trading day of a given equity or currency exchange pair.
@@ -15,3 +20,1505 @@ func NewTechnicalIndicatorsService(s *Service) *TechnicalIndicatorsService {
 type TechnicalIndicatorsService struct {
 	s *Service
 }
+
+// Indicator https://www.alphavantage.co/documentation/#technical-indicators
+// This API returns the values of any technical indicator function, e.g. SMA, as named float columns.
+// Parameters shared by most indicators have typed setters, the others can be set by Param.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) Indicator(function, symbol, interval string) *IndicatorCall {
+	c := r.newIndicatorCall(function, symbol, interval)
+	return &c
+}
+
+func (r *TechnicalIndicatorsService) newIndicatorCall(function, symbol, interval string) IndicatorCall {
+	c := IndicatorCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+	c.urlParams.Set("function", function)
+	c.urlParams.Set("symbol", symbol)
+	c.urlParams.Set("interval", interval)
+	c.urlParams.Set("datatype", "csv")
+	return c
+}
+
+// IndicatorCall https://www.alphavantage.co/documentation/#technical-indicators
+// This API returns the values of any technical indicator function, e.g. SMA, as named float columns.
+// Parameters shared by most indicators have typed setters, the others can be set by Param.
+// datatype fixed to csv
+type IndicatorCall struct {
+	DefaultCall
+}
+
+// Interval Time interval between two consecutive data points in the time series.
+// The following values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+func (c *IndicatorCall) Interval(interval string) *IndicatorCall {
+	c.urlParams.Set("interval", interval)
+
+	return c
+}
+
+// TimePeriod Number of data points used to calculate each indicator value.
+// Positive integers are accepted (e.g., time_period=60, time_period=200)
+func (c *IndicatorCall) TimePeriod(timePeriod int) *IndicatorCall {
+	c.urlParams.Set("time_period", strconv.Itoa(timePeriod))
+
+	return c
+}
+
+// SeriesType The desired price type in the time series.
+// Four types are supported: close, open, high, low
+func (c *IndicatorCall) SeriesType(seriesType string) *IndicatorCall {
+	c.urlParams.Set("series_type", seriesType)
+
+	return c
+}
+
+// Param sets any other parameter of the indicator function, e.g. fastlimit
+func (c *IndicatorCall) Param(key, value string) *IndicatorCall {
+	c.urlParams.Set(key, value)
+
+	return c
+}
+
+// Do send request
+func (c *IndicatorCall) Do() (*IndicatorSeries, error) {
+	ret := &IndicatorSeries{}
+	sr, err := c.do(func(res *http.Response) error {
+		return errors.Wrapf(decodeIndicatorSeries(ret, res), "decodeIndicatorSeries")
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret.ServerResponse = sr
+
+	return ret, nil
+}
+
+// SMA https://www.alphavantage.co/documentation/#sma
+// This API returns the simple moving average (SMA) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) SMA(symbol, interval string, timePeriod int, seriesType string) *SMACall {
+	c := &SMACall{
+		IndicatorCall: r.newIndicatorCall("SMA", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// SMACall https://www.alphavantage.co/documentation/#sma
+// This API returns the simple moving average (SMA) values.
+// datatype fixed to csv
+type SMACall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *SMACall) Do() (*SMAList, error) {
+	target := new([]*SMA)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &SMAList{
+		ServerResponse: sr,
+		SMA:            *target,
+	}
+
+	return ret, nil
+}
+
+// EMA https://www.alphavantage.co/documentation/#ema
+// This API returns the exponential moving average (EMA) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) EMA(symbol, interval string, timePeriod int, seriesType string) *EMACall {
+	c := &EMACall{
+		IndicatorCall: r.newIndicatorCall("EMA", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// EMACall https://www.alphavantage.co/documentation/#ema
+// This API returns the exponential moving average (EMA) values.
+// datatype fixed to csv
+type EMACall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *EMACall) Do() (*EMAList, error) {
+	target := new([]*EMA)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &EMAList{
+		ServerResponse: sr,
+		EMA:            *target,
+	}
+
+	return ret, nil
+}
+
+// WMA https://www.alphavantage.co/documentation/#wma
+// This API returns the weighted moving average (WMA) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) WMA(symbol, interval string, timePeriod int, seriesType string) *WMACall {
+	c := &WMACall{
+		IndicatorCall: r.newIndicatorCall("WMA", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// WMACall https://www.alphavantage.co/documentation/#wma
+// This API returns the weighted moving average (WMA) values.
+// datatype fixed to csv
+type WMACall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *WMACall) Do() (*WMAList, error) {
+	target := new([]*WMA)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &WMAList{
+		ServerResponse: sr,
+		WMA:            *target,
+	}
+
+	return ret, nil
+}
+
+// DEMA https://www.alphavantage.co/documentation/#dema
+// This API returns the double exponential moving average (DEMA) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) DEMA(symbol, interval string, timePeriod int, seriesType string) *DEMACall {
+	c := &DEMACall{
+		IndicatorCall: r.newIndicatorCall("DEMA", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// DEMACall https://www.alphavantage.co/documentation/#dema
+// This API returns the double exponential moving average (DEMA) values.
+// datatype fixed to csv
+type DEMACall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *DEMACall) Do() (*DEMAList, error) {
+	target := new([]*DEMA)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &DEMAList{
+		ServerResponse: sr,
+		DEMA:           *target,
+	}
+
+	return ret, nil
+}
+
+// TEMA https://www.alphavantage.co/documentation/#tema
+// This API returns the triple exponential moving average (TEMA) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) TEMA(symbol, interval string, timePeriod int, seriesType string) *TEMACall {
+	c := &TEMACall{
+		IndicatorCall: r.newIndicatorCall("TEMA", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// TEMACall https://www.alphavantage.co/documentation/#tema
+// This API returns the triple exponential moving average (TEMA) values.
+// datatype fixed to csv
+type TEMACall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *TEMACall) Do() (*TEMAList, error) {
+	target := new([]*TEMA)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &TEMAList{
+		ServerResponse: sr,
+		TEMA:           *target,
+	}
+
+	return ret, nil
+}
+
+// TRIMA https://www.alphavantage.co/documentation/#trima
+// This API returns the triangular moving average (TRIMA) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) TRIMA(symbol, interval string, timePeriod int, seriesType string) *TRIMACall {
+	c := &TRIMACall{
+		IndicatorCall: r.newIndicatorCall("TRIMA", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// TRIMACall https://www.alphavantage.co/documentation/#trima
+// This API returns the triangular moving average (TRIMA) values.
+// datatype fixed to csv
+type TRIMACall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *TRIMACall) Do() (*TRIMAList, error) {
+	target := new([]*TRIMA)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &TRIMAList{
+		ServerResponse: sr,
+		TRIMA:          *target,
+	}
+
+	return ret, nil
+}
+
+// KAMA https://www.alphavantage.co/documentation/#kama
+// This API returns the Kaufman adaptive moving average (KAMA) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) KAMA(symbol, interval string, timePeriod int, seriesType string) *KAMACall {
+	c := &KAMACall{
+		IndicatorCall: r.newIndicatorCall("KAMA", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// KAMACall https://www.alphavantage.co/documentation/#kama
+// This API returns the Kaufman adaptive moving average (KAMA) values.
+// datatype fixed to csv
+type KAMACall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *KAMACall) Do() (*KAMAList, error) {
+	target := new([]*KAMA)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &KAMAList{
+		ServerResponse: sr,
+		KAMA:           *target,
+	}
+
+	return ret, nil
+}
+
+// MAMA https://www.alphavantage.co/documentation/#mama
+// This API returns the MESA adaptive moving average (MAMA) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) MAMA(symbol, interval, seriesType string) *MAMACall {
+	c := &MAMACall{
+		IndicatorCall: r.newIndicatorCall("MAMA", symbol, interval),
+	}
+	c.SeriesType(seriesType)
+	return c
+}
+
+// MAMACall https://www.alphavantage.co/documentation/#mama
+// This API returns the MESA adaptive moving average (MAMA) values.
+// datatype fixed to csv
+type MAMACall struct {
+	IndicatorCall
+}
+
+// FastLimit Positive floats are accepted. By default, fastlimit=0.01.
+func (c *MAMACall) FastLimit(v float64) *MAMACall {
+	c.urlParams.Set("fastlimit", strconv.FormatFloat(v, 'f', -1, 64))
+
+	return c
+}
+
+// SlowLimit Positive floats are accepted. By default, slowlimit=0.01.
+func (c *MAMACall) SlowLimit(v float64) *MAMACall {
+	c.urlParams.Set("slowlimit", strconv.FormatFloat(v, 'f', -1, 64))
+
+	return c
+}
+
+// Do send request
+func (c *MAMACall) Do() (*MAMAList, error) {
+	target := new([]*MAMA)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &MAMAList{
+		ServerResponse: sr,
+		MAMA:           *target,
+	}
+
+	return ret, nil
+}
+
+// VWAP https://www.alphavantage.co/documentation/#vwap
+// This API returns the volume weighted average price (VWAP) for intraday time series.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) VWAP(symbol, interval string) *VWAPCall {
+	c := &VWAPCall{
+		IndicatorCall: r.newIndicatorCall("VWAP", symbol, interval),
+	}
+	return c
+}
+
+// VWAPCall https://www.alphavantage.co/documentation/#vwap
+// This API returns the volume weighted average price (VWAP) for intraday time series.
+// datatype fixed to csv
+type VWAPCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *VWAPCall) Do() (*VWAPList, error) {
+	target := new([]*VWAP)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &VWAPList{
+		ServerResponse: sr,
+		VWAP:           *target,
+	}
+
+	return ret, nil
+}
+
+// T3 https://www.alphavantage.co/documentation/#t3
+// This API returns the triple exponential moving average (T3) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) T3(symbol, interval string, timePeriod int, seriesType string) *T3Call {
+	c := &T3Call{
+		IndicatorCall: r.newIndicatorCall("T3", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// T3Call https://www.alphavantage.co/documentation/#t3
+// This API returns the triple exponential moving average (T3) values.
+// datatype fixed to csv
+type T3Call struct {
+	IndicatorCall
+}
+
+// VFactor The volume factor of the T3 generalized DEMA, between 0 and 1. By default, vfactor=0.7.
+func (c *T3Call) VFactor(v float64) *T3Call {
+	c.urlParams.Set("vfactor", strconv.FormatFloat(v, 'f', -1, 64))
+
+	return c
+}
+
+// Do send request
+func (c *T3Call) Do() (*T3List, error) {
+	target := new([]*T3)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &T3List{
+		ServerResponse: sr,
+		T3:             *target,
+	}
+
+	return ret, nil
+}
+
+// MACD https://www.alphavantage.co/documentation/#macd
+// This API returns the moving average convergence / divergence (MACD) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) MACD(symbol, interval, seriesType string) *MACDCall {
+	c := &MACDCall{
+		IndicatorCall: r.newIndicatorCall("MACD", symbol, interval),
+	}
+	c.SeriesType(seriesType)
+	return c
+}
+
+// MACDCall https://www.alphavantage.co/documentation/#macd
+// This API returns the moving average convergence / divergence (MACD) values.
+// datatype fixed to csv
+type MACDCall struct {
+	IndicatorCall
+}
+
+// FastPeriod Positive integers are accepted. By default, fastperiod=12.
+func (c *MACDCall) FastPeriod(v int) *MACDCall {
+	c.urlParams.Set("fastperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// SlowPeriod Positive integers are accepted. By default, slowperiod=26.
+func (c *MACDCall) SlowPeriod(v int) *MACDCall {
+	c.urlParams.Set("slowperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// SignalPeriod Positive integers are accepted. By default, signalperiod=9.
+func (c *MACDCall) SignalPeriod(v int) *MACDCall {
+	c.urlParams.Set("signalperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// Do send request
+func (c *MACDCall) Do() (*MACDList, error) {
+	target := new([]*MACD)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &MACDList{
+		ServerResponse: sr,
+		MACD:           *target,
+	}
+
+	return ret, nil
+}
+
+// MACDEXT https://www.alphavantage.co/documentation/#macdext
+// This API returns the moving average convergence / divergence values with controllable moving average type.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) MACDEXT(symbol, interval, seriesType string) *MACDEXTCall {
+	c := &MACDEXTCall{
+		IndicatorCall: r.newIndicatorCall("MACDEXT", symbol, interval),
+	}
+	c.SeriesType(seriesType)
+	return c
+}
+
+// MACDEXTCall https://www.alphavantage.co/documentation/#macdext
+// This API returns the moving average convergence / divergence values with controllable moving average type.
+// datatype fixed to csv
+type MACDEXTCall struct {
+	IndicatorCall
+}
+
+// FastPeriod Positive integers are accepted. By default, fastperiod=12.
+func (c *MACDEXTCall) FastPeriod(v int) *MACDEXTCall {
+	c.urlParams.Set("fastperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// SlowPeriod Positive integers are accepted. By default, slowperiod=26.
+func (c *MACDEXTCall) SlowPeriod(v int) *MACDEXTCall {
+	c.urlParams.Set("slowperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// SignalPeriod Positive integers are accepted. By default, signalperiod=9.
+func (c *MACDEXTCall) SignalPeriod(v int) *MACDEXTCall {
+	c.urlParams.Set("signalperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// FastMAType Moving average type of the fast line. By default, fastmatype=0 (MATypeSMA).
+func (c *MACDEXTCall) FastMAType(v MAType) *MACDEXTCall {
+	c.urlParams.Set("fastmatype", strconv.Itoa(int(v)))
+
+	return c
+}
+
+// SlowMAType Moving average type of the slow line. By default, slowmatype=0 (MATypeSMA).
+func (c *MACDEXTCall) SlowMAType(v MAType) *MACDEXTCall {
+	c.urlParams.Set("slowmatype", strconv.Itoa(int(v)))
+
+	return c
+}
+
+// SignalMAType Moving average type of the signal line. By default, signalmatype=0 (MATypeSMA).
+func (c *MACDEXTCall) SignalMAType(v MAType) *MACDEXTCall {
+	c.urlParams.Set("signalmatype", strconv.Itoa(int(v)))
+
+	return c
+}
+
+// Do send request
+func (c *MACDEXTCall) Do() (*MACDEXTList, error) {
+	target := new([]*MACDEXT)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &MACDEXTList{
+		ServerResponse: sr,
+		MACDEXT:        *target,
+	}
+
+	return ret, nil
+}
+
+// STOCH https://www.alphavantage.co/documentation/#stoch
+// This API returns the stochastic oscillator (STOCH) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) STOCH(symbol, interval string) *STOCHCall {
+	c := &STOCHCall{
+		IndicatorCall: r.newIndicatorCall("STOCH", symbol, interval),
+	}
+	return c
+}
+
+// STOCHCall https://www.alphavantage.co/documentation/#stoch
+// This API returns the stochastic oscillator (STOCH) values.
+// datatype fixed to csv
+type STOCHCall struct {
+	IndicatorCall
+}
+
+// FastKPeriod Positive integers are accepted. By default, fastkperiod=5.
+func (c *STOCHCall) FastKPeriod(v int) *STOCHCall {
+	c.urlParams.Set("fastkperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// SlowKPeriod Positive integers are accepted. By default, slowkperiod=3.
+func (c *STOCHCall) SlowKPeriod(v int) *STOCHCall {
+	c.urlParams.Set("slowkperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// SlowDPeriod Positive integers are accepted. By default, slowdperiod=3.
+func (c *STOCHCall) SlowDPeriod(v int) *STOCHCall {
+	c.urlParams.Set("slowdperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// SlowKMAType Moving average type of the slowk line. By default, slowkmatype=0 (MATypeSMA).
+func (c *STOCHCall) SlowKMAType(v MAType) *STOCHCall {
+	c.urlParams.Set("slowkmatype", strconv.Itoa(int(v)))
+
+	return c
+}
+
+// SlowDMAType Moving average type of the slowd line. By default, slowdmatype=0 (MATypeSMA).
+func (c *STOCHCall) SlowDMAType(v MAType) *STOCHCall {
+	c.urlParams.Set("slowdmatype", strconv.Itoa(int(v)))
+
+	return c
+}
+
+// Do send request
+func (c *STOCHCall) Do() (*STOCHList, error) {
+	target := new([]*STOCH)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &STOCHList{
+		ServerResponse: sr,
+		STOCH:          *target,
+	}
+
+	return ret, nil
+}
+
+// STOCHF https://www.alphavantage.co/documentation/#stochf
+// This API returns the stochastic fast (STOCHF) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) STOCHF(symbol, interval string) *STOCHFCall {
+	c := &STOCHFCall{
+		IndicatorCall: r.newIndicatorCall("STOCHF", symbol, interval),
+	}
+	return c
+}
+
+// STOCHFCall https://www.alphavantage.co/documentation/#stochf
+// This API returns the stochastic fast (STOCHF) values.
+// datatype fixed to csv
+type STOCHFCall struct {
+	IndicatorCall
+}
+
+// FastKPeriod Positive integers are accepted. By default, fastkperiod=5.
+func (c *STOCHFCall) FastKPeriod(v int) *STOCHFCall {
+	c.urlParams.Set("fastkperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// FastDPeriod Positive integers are accepted. By default, fastdperiod=3.
+func (c *STOCHFCall) FastDPeriod(v int) *STOCHFCall {
+	c.urlParams.Set("fastdperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// FastDMAType Moving average type of the fastd line. By default, fastdmatype=0 (MATypeSMA).
+func (c *STOCHFCall) FastDMAType(v MAType) *STOCHFCall {
+	c.urlParams.Set("fastdmatype", strconv.Itoa(int(v)))
+
+	return c
+}
+
+// Do send request
+func (c *STOCHFCall) Do() (*STOCHFList, error) {
+	target := new([]*STOCHF)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &STOCHFList{
+		ServerResponse: sr,
+		STOCHF:         *target,
+	}
+
+	return ret, nil
+}
+
+// RSI https://www.alphavantage.co/documentation/#rsi
+// This API returns the relative strength index (RSI) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) RSI(symbol, interval string, timePeriod int, seriesType string) *RSICall {
+	c := &RSICall{
+		IndicatorCall: r.newIndicatorCall("RSI", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// RSICall https://www.alphavantage.co/documentation/#rsi
+// This API returns the relative strength index (RSI) values.
+// datatype fixed to csv
+type RSICall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *RSICall) Do() (*RSIList, error) {
+	target := new([]*RSI)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &RSIList{
+		ServerResponse: sr,
+		RSI:            *target,
+	}
+
+	return ret, nil
+}
+
+// STOCHRSI https://www.alphavantage.co/documentation/#stochrsi
+// This API returns the stochastic relative strength index (STOCHRSI) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) STOCHRSI(symbol, interval string, timePeriod int, seriesType string) *STOCHRSICall {
+	c := &STOCHRSICall{
+		IndicatorCall: r.newIndicatorCall("STOCHRSI", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// STOCHRSICall https://www.alphavantage.co/documentation/#stochrsi
+// This API returns the stochastic relative strength index (STOCHRSI) values.
+// datatype fixed to csv
+type STOCHRSICall struct {
+	IndicatorCall
+}
+
+// FastKPeriod Positive integers are accepted. By default, fastkperiod=5.
+func (c *STOCHRSICall) FastKPeriod(v int) *STOCHRSICall {
+	c.urlParams.Set("fastkperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// FastDPeriod Positive integers are accepted. By default, fastdperiod=3.
+func (c *STOCHRSICall) FastDPeriod(v int) *STOCHRSICall {
+	c.urlParams.Set("fastdperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// FastDMAType Moving average type of the fastd line. By default, fastdmatype=0 (MATypeSMA).
+func (c *STOCHRSICall) FastDMAType(v MAType) *STOCHRSICall {
+	c.urlParams.Set("fastdmatype", strconv.Itoa(int(v)))
+
+	return c
+}
+
+// Do send request
+func (c *STOCHRSICall) Do() (*STOCHRSIList, error) {
+	target := new([]*STOCHRSI)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &STOCHRSIList{
+		ServerResponse: sr,
+		STOCHRSI:       *target,
+	}
+
+	return ret, nil
+}
+
+// WILLR https://www.alphavantage.co/documentation/#willr
+// This API returns the Williams' %R (WILLR) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) WILLR(symbol, interval string, timePeriod int) *WILLRCall {
+	c := &WILLRCall{
+		IndicatorCall: r.newIndicatorCall("WILLR", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	return c
+}
+
+// WILLRCall https://www.alphavantage.co/documentation/#willr
+// This API returns the Williams' %R (WILLR) values.
+// datatype fixed to csv
+type WILLRCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *WILLRCall) Do() (*WILLRList, error) {
+	target := new([]*WILLR)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &WILLRList{
+		ServerResponse: sr,
+		WILLR:          *target,
+	}
+
+	return ret, nil
+}
+
+// ADX https://www.alphavantage.co/documentation/#adx
+// This API returns the average directional movement index (ADX) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) ADX(symbol, interval string, timePeriod int) *ADXCall {
+	c := &ADXCall{
+		IndicatorCall: r.newIndicatorCall("ADX", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	return c
+}
+
+// ADXCall https://www.alphavantage.co/documentation/#adx
+// This API returns the average directional movement index (ADX) values.
+// datatype fixed to csv
+type ADXCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *ADXCall) Do() (*ADXList, error) {
+	target := new([]*ADX)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &ADXList{
+		ServerResponse: sr,
+		ADX:            *target,
+	}
+
+	return ret, nil
+}
+
+// CCI https://www.alphavantage.co/documentation/#cci
+// This API returns the commodity channel index (CCI) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) CCI(symbol, interval string, timePeriod int) *CCICall {
+	c := &CCICall{
+		IndicatorCall: r.newIndicatorCall("CCI", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	return c
+}
+
+// CCICall https://www.alphavantage.co/documentation/#cci
+// This API returns the commodity channel index (CCI) values.
+// datatype fixed to csv
+type CCICall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *CCICall) Do() (*CCIList, error) {
+	target := new([]*CCI)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &CCIList{
+		ServerResponse: sr,
+		CCI:            *target,
+	}
+
+	return ret, nil
+}
+
+// AROON https://www.alphavantage.co/documentation/#aroon
+// This API returns the Aroon (AROON) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) AROON(symbol, interval string, timePeriod int) *AROONCall {
+	c := &AROONCall{
+		IndicatorCall: r.newIndicatorCall("AROON", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	return c
+}
+
+// AROONCall https://www.alphavantage.co/documentation/#aroon
+// This API returns the Aroon (AROON) values.
+// datatype fixed to csv
+type AROONCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *AROONCall) Do() (*AROONList, error) {
+	target := new([]*AROON)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &AROONList{
+		ServerResponse: sr,
+		AROON:          *target,
+	}
+
+	return ret, nil
+}
+
+// MFI https://www.alphavantage.co/documentation/#mfi
+// This API returns the money flow index (MFI) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) MFI(symbol, interval string, timePeriod int) *MFICall {
+	c := &MFICall{
+		IndicatorCall: r.newIndicatorCall("MFI", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	return c
+}
+
+// MFICall https://www.alphavantage.co/documentation/#mfi
+// This API returns the money flow index (MFI) values.
+// datatype fixed to csv
+type MFICall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *MFICall) Do() (*MFIList, error) {
+	target := new([]*MFI)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &MFIList{
+		ServerResponse: sr,
+		MFI:            *target,
+	}
+
+	return ret, nil
+}
+
+// MOM https://www.alphavantage.co/documentation/#mom
+// This API returns the momentum (MOM) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) MOM(symbol, interval string, timePeriod int, seriesType string) *MOMCall {
+	c := &MOMCall{
+		IndicatorCall: r.newIndicatorCall("MOM", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// MOMCall https://www.alphavantage.co/documentation/#mom
+// This API returns the momentum (MOM) values.
+// datatype fixed to csv
+type MOMCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *MOMCall) Do() (*MOMList, error) {
+	target := new([]*MOM)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &MOMList{
+		ServerResponse: sr,
+		MOM:            *target,
+	}
+
+	return ret, nil
+}
+
+// ROC https://www.alphavantage.co/documentation/#roc
+// This API returns the rate of change (ROC) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) ROC(symbol, interval string, timePeriod int, seriesType string) *ROCCall {
+	c := &ROCCall{
+		IndicatorCall: r.newIndicatorCall("ROC", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// ROCCall https://www.alphavantage.co/documentation/#roc
+// This API returns the rate of change (ROC) values.
+// datatype fixed to csv
+type ROCCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *ROCCall) Do() (*ROCList, error) {
+	target := new([]*ROC)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &ROCList{
+		ServerResponse: sr,
+		ROC:            *target,
+	}
+
+	return ret, nil
+}
+
+// BBANDS https://www.alphavantage.co/documentation/#bbands
+// This API returns the Bollinger bands (BBANDS) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) BBANDS(symbol, interval string, timePeriod int, seriesType string) *BBANDSCall {
+	c := &BBANDSCall{
+		IndicatorCall: r.newIndicatorCall("BBANDS", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	c.SeriesType(seriesType)
+	return c
+}
+
+// BBANDSCall https://www.alphavantage.co/documentation/#bbands
+// This API returns the Bollinger bands (BBANDS) values.
+// datatype fixed to csv
+type BBANDSCall struct {
+	IndicatorCall
+}
+
+// NBDevUp The standard deviation multiplier of the upper band. Positive integers are accepted. By default, nbdevup=2.
+func (c *BBANDSCall) NBDevUp(v int) *BBANDSCall {
+	c.urlParams.Set("nbdevup", strconv.Itoa(v))
+
+	return c
+}
+
+// NBDevDn The standard deviation multiplier of the lower band. Positive integers are accepted. By default, nbdevdn=2.
+func (c *BBANDSCall) NBDevDn(v int) *BBANDSCall {
+	c.urlParams.Set("nbdevdn", strconv.Itoa(v))
+
+	return c
+}
+
+// MAType Moving average type of the middle band. By default, matype=0 (MATypeSMA).
+func (c *BBANDSCall) MAType(v MAType) *BBANDSCall {
+	c.urlParams.Set("matype", strconv.Itoa(int(v)))
+
+	return c
+}
+
+// Do send request
+func (c *BBANDSCall) Do() (*BBANDSList, error) {
+	target := new([]*BBANDS)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &BBANDSList{
+		ServerResponse: sr,
+		BBANDS:         *target,
+	}
+
+	return ret, nil
+}
+
+// ATR https://www.alphavantage.co/documentation/#atr
+// This API returns the average true range (ATR) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) ATR(symbol, interval string, timePeriod int) *ATRCall {
+	c := &ATRCall{
+		IndicatorCall: r.newIndicatorCall("ATR", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	return c
+}
+
+// ATRCall https://www.alphavantage.co/documentation/#atr
+// This API returns the average true range (ATR) values.
+// datatype fixed to csv
+type ATRCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *ATRCall) Do() (*ATRList, error) {
+	target := new([]*ATR)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &ATRList{
+		ServerResponse: sr,
+		ATR:            *target,
+	}
+
+	return ret, nil
+}
+
+// NATR https://www.alphavantage.co/documentation/#natr
+// This API returns the normalized average true range (NATR) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) NATR(symbol, interval string, timePeriod int) *NATRCall {
+	c := &NATRCall{
+		IndicatorCall: r.newIndicatorCall("NATR", symbol, interval),
+	}
+	c.TimePeriod(timePeriod)
+	return c
+}
+
+// NATRCall https://www.alphavantage.co/documentation/#natr
+// This API returns the normalized average true range (NATR) values.
+// datatype fixed to csv
+type NATRCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *NATRCall) Do() (*NATRList, error) {
+	target := new([]*NATR)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &NATRList{
+		ServerResponse: sr,
+		NATR:           *target,
+	}
+
+	return ret, nil
+}
+
+// TRANGE https://www.alphavantage.co/documentation/#trange
+// This API returns the true range (TRANGE) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) TRANGE(symbol, interval string) *TRANGECall {
+	c := &TRANGECall{
+		IndicatorCall: r.newIndicatorCall("TRANGE", symbol, interval),
+	}
+	return c
+}
+
+// TRANGECall https://www.alphavantage.co/documentation/#trange
+// This API returns the true range (TRANGE) values.
+// datatype fixed to csv
+type TRANGECall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *TRANGECall) Do() (*TRANGEList, error) {
+	target := new([]*TRANGE)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &TRANGEList{
+		ServerResponse: sr,
+		TRANGE:         *target,
+	}
+
+	return ret, nil
+}
+
+// AD https://www.alphavantage.co/documentation/#ad
+// This API returns the Chaikin A/D line (AD) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) AD(symbol, interval string) *ADCall {
+	c := &ADCall{
+		IndicatorCall: r.newIndicatorCall("AD", symbol, interval),
+	}
+	return c
+}
+
+// ADCall https://www.alphavantage.co/documentation/#ad
+// This API returns the Chaikin A/D line (AD) values.
+// datatype fixed to csv
+type ADCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *ADCall) Do() (*ADList, error) {
+	target := new([]*AD)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &ADList{
+		ServerResponse: sr,
+		AD:             *target,
+	}
+
+	return ret, nil
+}
+
+// ADOSC https://www.alphavantage.co/documentation/#adosc
+// This API returns the Chaikin A/D oscillator (ADOSC) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) ADOSC(symbol, interval string) *ADOSCCall {
+	c := &ADOSCCall{
+		IndicatorCall: r.newIndicatorCall("ADOSC", symbol, interval),
+	}
+	return c
+}
+
+// ADOSCCall https://www.alphavantage.co/documentation/#adosc
+// This API returns the Chaikin A/D oscillator (ADOSC) values.
+// datatype fixed to csv
+type ADOSCCall struct {
+	IndicatorCall
+}
+
+// FastPeriod Positive integers are accepted. By default, fastperiod=3.
+func (c *ADOSCCall) FastPeriod(v int) *ADOSCCall {
+	c.urlParams.Set("fastperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// SlowPeriod Positive integers are accepted. By default, slowperiod=10.
+func (c *ADOSCCall) SlowPeriod(v int) *ADOSCCall {
+	c.urlParams.Set("slowperiod", strconv.Itoa(v))
+
+	return c
+}
+
+// Do send request
+func (c *ADOSCCall) Do() (*ADOSCList, error) {
+	target := new([]*ADOSC)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &ADOSCList{
+		ServerResponse: sr,
+		ADOSC:          *target,
+	}
+
+	return ret, nil
+}
+
+// OBV https://www.alphavantage.co/documentation/#obv
+// This API returns the on balance volume (OBV) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) OBV(symbol, interval string) *OBVCall {
+	c := &OBVCall{
+		IndicatorCall: r.newIndicatorCall("OBV", symbol, interval),
+	}
+	return c
+}
+
+// OBVCall https://www.alphavantage.co/documentation/#obv
+// This API returns the on balance volume (OBV) values.
+// datatype fixed to csv
+type OBVCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *OBVCall) Do() (*OBVList, error) {
+	target := new([]*OBV)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &OBVList{
+		ServerResponse: sr,
+		OBV:            *target,
+	}
+
+	return ret, nil
+}
+
+// HTTrendline https://www.alphavantage.co/documentation/#httrendline
+// This API returns the Hilbert transform, instantaneous trendline (HT_TRENDLINE) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) HTTrendline(symbol, interval, seriesType string) *HTTrendlineCall {
+	c := &HTTrendlineCall{
+		IndicatorCall: r.newIndicatorCall("HT_TRENDLINE", symbol, interval),
+	}
+	c.SeriesType(seriesType)
+	return c
+}
+
+// HTTrendlineCall https://www.alphavantage.co/documentation/#httrendline
+// This API returns the Hilbert transform, instantaneous trendline (HT_TRENDLINE) values.
+// datatype fixed to csv
+type HTTrendlineCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *HTTrendlineCall) Do() (*HTTrendlineList, error) {
+	target := new([]*HTTrendline)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &HTTrendlineList{
+		ServerResponse: sr,
+		HTTrendline:    *target,
+	}
+
+	return ret, nil
+}
+
+// HTSine https://www.alphavantage.co/documentation/#htsine
+// This API returns the Hilbert transform, sine wave (HT_SINE) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) HTSine(symbol, interval, seriesType string) *HTSineCall {
+	c := &HTSineCall{
+		IndicatorCall: r.newIndicatorCall("HT_SINE", symbol, interval),
+	}
+	c.SeriesType(seriesType)
+	return c
+}
+
+// HTSineCall https://www.alphavantage.co/documentation/#htsine
+// This API returns the Hilbert transform, sine wave (HT_SINE) values.
+// datatype fixed to csv
+type HTSineCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *HTSineCall) Do() (*HTSineList, error) {
+	target := new([]*HTSine)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &HTSineList{
+		ServerResponse: sr,
+		HTSine:         *target,
+	}
+
+	return ret, nil
+}
+
+// HTTrendMode https://www.alphavantage.co/documentation/#httrendmode
+// This API returns the Hilbert transform, trend vs cycle mode (HT_TRENDMODE) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) HTTrendMode(symbol, interval, seriesType string) *HTTrendModeCall {
+	c := &HTTrendModeCall{
+		IndicatorCall: r.newIndicatorCall("HT_TRENDMODE", symbol, interval),
+	}
+	c.SeriesType(seriesType)
+	return c
+}
+
+// HTTrendModeCall https://www.alphavantage.co/documentation/#httrendmode
+// This API returns the Hilbert transform, trend vs cycle mode (HT_TRENDMODE) values.
+// datatype fixed to csv
+type HTTrendModeCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *HTTrendModeCall) Do() (*HTTrendModeList, error) {
+	target := new([]*HTTrendMode)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &HTTrendModeList{
+		ServerResponse: sr,
+		HTTrendMode:    *target,
+	}
+
+	return ret, nil
+}
+
+// HTDCPeriod https://www.alphavantage.co/documentation/#htdcperiod
+// This API returns the Hilbert transform, dominant cycle period (HT_DCPERIOD) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) HTDCPeriod(symbol, interval, seriesType string) *HTDCPeriodCall {
+	c := &HTDCPeriodCall{
+		IndicatorCall: r.newIndicatorCall("HT_DCPERIOD", symbol, interval),
+	}
+	c.SeriesType(seriesType)
+	return c
+}
+
+// HTDCPeriodCall https://www.alphavantage.co/documentation/#htdcperiod
+// This API returns the Hilbert transform, dominant cycle period (HT_DCPERIOD) values.
+// datatype fixed to csv
+type HTDCPeriodCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *HTDCPeriodCall) Do() (*HTDCPeriodList, error) {
+	target := new([]*HTDCPeriod)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &HTDCPeriodList{
+		ServerResponse: sr,
+		HTDCPeriod:     *target,
+	}
+
+	return ret, nil
+}
+
+// HTDCPhase https://www.alphavantage.co/documentation/#htdcphase
+// This API returns the Hilbert transform, dominant cycle phase (HT_DCPHASE) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) HTDCPhase(symbol, interval, seriesType string) *HTDCPhaseCall {
+	c := &HTDCPhaseCall{
+		IndicatorCall: r.newIndicatorCall("HT_DCPHASE", symbol, interval),
+	}
+	c.SeriesType(seriesType)
+	return c
+}
+
+// HTDCPhaseCall https://www.alphavantage.co/documentation/#htdcphase
+// This API returns the Hilbert transform, dominant cycle phase (HT_DCPHASE) values.
+// datatype fixed to csv
+type HTDCPhaseCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *HTDCPhaseCall) Do() (*HTDCPhaseList, error) {
+	target := new([]*HTDCPhase)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &HTDCPhaseList{
+		ServerResponse: sr,
+		HTDCPhase:      *target,
+	}
+
+	return ret, nil
+}
+
+// HTPhasor https://www.alphavantage.co/documentation/#htphasor
+// This API returns the Hilbert transform, phasor components (HT_PHASOR) values.
+// datatype fixed to csv
+func (r *TechnicalIndicatorsService) HTPhasor(symbol, interval, seriesType string) *HTPhasorCall {
+	c := &HTPhasorCall{
+		IndicatorCall: r.newIndicatorCall("HT_PHASOR", symbol, interval),
+	}
+	c.SeriesType(seriesType)
+	return c
+}
+
+// HTPhasorCall https://www.alphavantage.co/documentation/#htphasor
+// This API returns the Hilbert transform, phasor components (HT_PHASOR) values.
+// datatype fixed to csv
+type HTPhasorCall struct {
+	IndicatorCall
+}
+
+// Do send request
+func (c *HTPhasorCall) Do() (*HTPhasorList, error) {
+	target := new([]*HTPhasor)
+	sr, err := c.doCSV(target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &HTPhasorList{
+		ServerResponse: sr,
+		HTPhasor:       *target,
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,649 @@
+package alphavantage
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// IndicatorValue values of all indicator columns at one time
+type IndicatorValue struct {
+	Time   Time
+	Values map[string]float64
+}
+
+// IndicatorSeries technical indicator series with named float columns
+type IndicatorSeries struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse
+
+	// Columns are the names of the indicator columns in response order, e.g. MACD, MACD_Hist, MACD_Signal
+	Columns []string
+
+	Indicators []*IndicatorValue
+}
+
+// decodeIndicatorSeries decodes the csv body of res, whose first column is the time
+// and the others are indicator values
+func decodeIndicatorSeries(target *IndicatorSeries, res *http.Response) error {
+	if res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	b, err := readResponseBody(res)
+	if err != nil {
+		return err
+	}
+
+	r := csv.NewReader(bytes.NewReader(b))
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "csv.Reader.Read")
+	}
+	if len(header) == 0 {
+		return nil
+	}
+	target.Columns = header[1:]
+
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrapf(err, "csv.Reader.Read")
+		}
+
+		v := &IndicatorValue{Values: make(map[string]float64, len(target.Columns))}
+		if err := v.Time.UnmarshalCSV([]byte(record[0])); err != nil {
+			return errors.Wrapf(err, "Time.UnmarshalCSV")
+		}
+		for i, column := range target.Columns {
+			f, err := strconv.ParseFloat(record[i+1], 64)
+			if err != nil {
+				return errors.Wrapf(err, "strconv.ParseFloat")
+			}
+			v.Values[column] = f
+		}
+		target.Indicators = append(target.Indicators, v)
+	}
+
+	return nil
+}
+
+// SMA simple moving average
+type SMA struct {
+	Time Time    `csv:"time"`
+	SMA  float64 `csv:"SMA"`
+}
+
+// SMAList SMA List
+type SMAList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	SMA []*SMA
+}
+
+// EMA exponential moving average
+type EMA struct {
+	Time Time    `csv:"time"`
+	EMA  float64 `csv:"EMA"`
+}
+
+// EMAList EMA List
+type EMAList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	EMA []*EMA
+}
+
+// WMA weighted moving average
+type WMA struct {
+	Time Time    `csv:"time"`
+	WMA  float64 `csv:"WMA"`
+}
+
+// WMAList WMA List
+type WMAList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	WMA []*WMA
+}
+
+// DEMA double exponential moving average
+type DEMA struct {
+	Time Time    `csv:"time"`
+	DEMA float64 `csv:"DEMA"`
+}
+
+// DEMAList DEMA List
+type DEMAList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	DEMA []*DEMA
+}
+
+// TEMA triple exponential moving average
+type TEMA struct {
+	Time Time    `csv:"time"`
+	TEMA float64 `csv:"TEMA"`
+}
+
+// TEMAList TEMA List
+type TEMAList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	TEMA []*TEMA
+}
+
+// TRIMA triangular moving average
+type TRIMA struct {
+	Time  Time    `csv:"time"`
+	TRIMA float64 `csv:"TRIMA"`
+}
+
+// TRIMAList TRIMA List
+type TRIMAList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	TRIMA []*TRIMA
+}
+
+// KAMA Kaufman adaptive moving average
+type KAMA struct {
+	Time Time    `csv:"time"`
+	KAMA float64 `csv:"KAMA"`
+}
+
+// KAMAList KAMA List
+type KAMAList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	KAMA []*KAMA
+}
+
+// MAMA MESA adaptive moving average and following adaptive moving average
+type MAMA struct {
+	Time Time    `csv:"time"`
+	MAMA float64 `csv:"MAMA"`
+	FAMA float64 `csv:"FAMA"`
+}
+
+// MAMAList MAMA List
+type MAMAList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	MAMA []*MAMA
+}
+
+// VWAP volume weighted average price
+type VWAP struct {
+	Time Time    `csv:"time"`
+	VWAP float64 `csv:"VWAP"`
+}
+
+// VWAPList VWAP List
+type VWAPList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	VWAP []*VWAP
+}
+
+// T3 triple exponential moving average (T3)
+type T3 struct {
+	Time Time    `csv:"time"`
+	T3   float64 `csv:"T3"`
+}
+
+// T3List T3 List
+type T3List struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	T3 []*T3
+}
+
+// MAType moving average type used by indicators such as MACDEXT and STOCH
+type MAType int
+
+// Moving average types
+const (
+	MATypeSMA MAType = iota
+	MATypeEMA
+	MATypeWMA
+	MATypeDEMA
+	MATypeTEMA
+	MATypeTRIMA
+	MATypeT3
+	MATypeKAMA
+	MATypeMAMA
+)
+
+// MACD moving average convergence / divergence
+type MACD struct {
+	Time       Time    `csv:"time"`
+	MACD       float64 `csv:"MACD"`
+	MACDHist   float64 `csv:"MACD_Hist"`
+	MACDSignal float64 `csv:"MACD_Signal"`
+}
+
+// MACDList MACD List
+type MACDList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	MACD []*MACD
+}
+
+// MACDEXT moving average convergence / divergence with controllable moving average type
+type MACDEXT struct {
+	Time       Time    `csv:"time"`
+	MACD       float64 `csv:"MACD"`
+	MACDHist   float64 `csv:"MACD_Hist"`
+	MACDSignal float64 `csv:"MACD_Signal"`
+}
+
+// MACDEXTList MACDEXT List
+type MACDEXTList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	MACDEXT []*MACDEXT
+}
+
+// STOCH stochastic oscillator
+type STOCH struct {
+	Time  Time    `csv:"time"`
+	SlowK float64 `csv:"SlowK"`
+	SlowD float64 `csv:"SlowD"`
+}
+
+// STOCHList STOCH List
+type STOCHList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	STOCH []*STOCH
+}
+
+// STOCHF stochastic fast
+type STOCHF struct {
+	Time  Time    `csv:"time"`
+	FastK float64 `csv:"FastK"`
+	FastD float64 `csv:"FastD"`
+}
+
+// STOCHFList STOCHF List
+type STOCHFList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	STOCHF []*STOCHF
+}
+
+// RSI relative strength index
+type RSI struct {
+	Time Time    `csv:"time"`
+	RSI  float64 `csv:"RSI"`
+}
+
+// RSIList RSI List
+type RSIList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	RSI []*RSI
+}
+
+// STOCHRSI stochastic relative strength index
+type STOCHRSI struct {
+	Time  Time    `csv:"time"`
+	FastK float64 `csv:"FastK"`
+	FastD float64 `csv:"FastD"`
+}
+
+// STOCHRSIList STOCHRSI List
+type STOCHRSIList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	STOCHRSI []*STOCHRSI
+}
+
+// WILLR Williams' %R
+type WILLR struct {
+	Time  Time    `csv:"time"`
+	WILLR float64 `csv:"WILLR"`
+}
+
+// WILLRList WILLR List
+type WILLRList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	WILLR []*WILLR
+}
+
+// ADX average directional movement index
+type ADX struct {
+	Time Time    `csv:"time"`
+	ADX  float64 `csv:"ADX"`
+}
+
+// ADXList ADX List
+type ADXList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	ADX []*ADX
+}
+
+// CCI commodity channel index
+type CCI struct {
+	Time Time    `csv:"time"`
+	CCI  float64 `csv:"CCI"`
+}
+
+// CCIList CCI List
+type CCIList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	CCI []*CCI
+}
+
+// AROON Aroon
+type AROON struct {
+	Time      Time    `csv:"time"`
+	AroonDown float64 `csv:"Aroon Down"`
+	AroonUp   float64 `csv:"Aroon Up"`
+}
+
+// AROONList AROON List
+type AROONList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	AROON []*AROON
+}
+
+// MFI money flow index
+type MFI struct {
+	Time Time    `csv:"time"`
+	MFI  float64 `csv:"MFI"`
+}
+
+// MFIList MFI List
+type MFIList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	MFI []*MFI
+}
+
+// MOM momentum
+type MOM struct {
+	Time Time    `csv:"time"`
+	MOM  float64 `csv:"MOM"`
+}
+
+// MOMList MOM List
+type MOMList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	MOM []*MOM
+}
+
+// ROC rate of change
+type ROC struct {
+	Time Time    `csv:"time"`
+	ROC  float64 `csv:"ROC"`
+}
+
+// ROCList ROC List
+type ROCList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	ROC []*ROC
+}
+
+// BBANDS Bollinger bands
+type BBANDS struct {
+	Time           Time    `csv:"time"`
+	RealUpperBand  float64 `csv:"Real Upper Band"`
+	RealMiddleBand float64 `csv:"Real Middle Band"`
+	RealLowerBand  float64 `csv:"Real Lower Band"`
+}
+
+// BBANDSList BBANDS List
+type BBANDSList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	BBANDS []*BBANDS
+}
+
+// ATR average true range
+type ATR struct {
+	Time Time    `csv:"time"`
+	ATR  float64 `csv:"ATR"`
+}
+
+// ATRList ATR List
+type ATRList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	ATR []*ATR
+}
+
+// NATR normalized average true range
+type NATR struct {
+	Time Time    `csv:"time"`
+	NATR float64 `csv:"NATR"`
+}
+
+// NATRList NATR List
+type NATRList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	NATR []*NATR
+}
+
+// TRANGE true range
+type TRANGE struct {
+	Time   Time    `csv:"time"`
+	TRANGE float64 `csv:"TRANGE"`
+}
+
+// TRANGEList TRANGE List
+type TRANGEList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	TRANGE []*TRANGE
+}
+
+// AD Chaikin A/D line
+type AD struct {
+	Time      Time    `csv:"time"`
+	ChaikinAD float64 `csv:"Chaikin A/D"`
+}
+
+// ADList AD List
+type ADList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	AD []*AD
+}
+
+// ADOSC Chaikin A/D oscillator
+type ADOSC struct {
+	Time  Time    `csv:"time"`
+	ADOSC float64 `csv:"ADOSC"`
+}
+
+// ADOSCList ADOSC List
+type ADOSCList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	ADOSC []*ADOSC
+}
+
+// OBV on balance volume
+type OBV struct {
+	Time Time    `csv:"time"`
+	OBV  float64 `csv:"OBV"`
+}
+
+// OBVList OBV List
+type OBVList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	OBV []*OBV
+}
+
+// HTTrendline Hilbert transform, instantaneous trendline
+type HTTrendline struct {
+	Time        Time    `csv:"time"`
+	HTTrendline float64 `csv:"HT_TRENDLINE"`
+}
+
+// HTTrendlineList HTTrendline List
+type HTTrendlineList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	HTTrendline []*HTTrendline
+}
+
+// HTSine Hilbert transform, sine wave
+type HTSine struct {
+	Time     Time    `csv:"time"`
+	Sine     float64 `csv:"SINE"`
+	LeadSine float64 `csv:"LEAD SINE"`
+}
+
+// HTSineList HTSine List
+type HTSineList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	HTSine []*HTSine
+}
+
+// HTTrendMode Hilbert transform, trend vs cycle mode
+type HTTrendMode struct {
+	Time      Time    `csv:"time"`
+	TrendMode float64 `csv:"TRENDMODE"`
+}
+
+// HTTrendModeList HTTrendMode List
+type HTTrendModeList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	HTTrendMode []*HTTrendMode
+}
+
+// HTDCPeriod Hilbert transform, dominant cycle period
+type HTDCPeriod struct {
+	Time     Time    `csv:"time"`
+	DCPeriod float64 `csv:"DCPERIOD"`
+}
+
+// HTDCPeriodList HTDCPeriod List
+type HTDCPeriodList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	HTDCPeriod []*HTDCPeriod
+}
+
+// HTDCPhase Hilbert transform, dominant cycle phase
+type HTDCPhase struct {
+	Time    Time    `csv:"time"`
+	DCPhase float64 `csv:"HT_DCPHASE"`
+}
+
+// HTDCPhaseList HTDCPhase List
+type HTDCPhaseList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	HTDCPhase []*HTDCPhase
+}
+
+// HTPhasor Hilbert transform, phasor components
+type HTPhasor struct {
+	Time       Time    `csv:"time"`
+	Phase      float64 `csv:"PHASE"`
+	Quadrature float64 `csv:"QUADRATURE"`
+}
+
+// HTPhasorList HTPhasor List
+type HTPhasorList struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `csv:"-"`
+
+	HTPhasor []*HTPhasor
+}
@@ -0,0 +1,1393 @@
+package alphavantage
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIndicatorCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *IndicatorCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).Indicator("SMA", "symbol", IndicatorIntervalDaily), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=SMA&interval=daily&symbol=symbol", false},
+		{"weekly", NewTechnicalIndicatorsService(avTest).Indicator("SMA", "symbol", IndicatorIntervalWeekly).TimePeriod(10).SeriesType(SeriesTypeOpen), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=SMA&interval=weekly&series_type=open&symbol=symbol&time_period=10", false},
+		{"interval", NewTechnicalIndicatorsService(avTest).Indicator("SMA", "symbol", IndicatorIntervalDaily).Interval(TimeSeriesIntervalFifteenMinute), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=SMA&interval=15min&symbol=symbol", false},
+		{"param", NewTechnicalIndicatorsService(avTest).Indicator("MAMA", "symbol", IndicatorIntervalMonthly).SeriesType(SeriesTypeClose).Param("fastlimit", "0.02"), "https://www.alphavantage.co/query?apikey=key&datatype=csv&fastlimit=0.02&function=MAMA&interval=monthly&series_type=close&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IndicatorCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndicatorCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndicatorCall_Do(t *testing.T) {
+	client := clientTest("key", `time,MACD,MACD_Hist,MACD_Signal
+2020-04-02 16:00,-0.2167,0.0453,-0.2620
+2020-04-02 15:45,-0.2460,0.0315,-0.2775`, http.StatusOK)
+	avTest, _ := New(client)
+	clientErr := clientTest("key", `{
+    "Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 500 calls per day."
+}`, http.StatusOK)
+	avErr, _ := New(clientErr)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *IndicatorCall
+		want    *IndicatorSeries
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewTechnicalIndicatorsService(avTest).Indicator("MACD", "symbol", TimeSeriesIntervalFifteenMinute).SeriesType(SeriesTypeClose), &IndicatorSeries{
+			ServerResponse: ServerResponse{HTTPStatusCode: 200, Header: http.Header{}},
+			Columns:        []string{"MACD", "MACD_Hist", "MACD_Signal"},
+			Indicators: []*IndicatorValue{
+				{Time: Time(time.Date(2020, time.April, 2, 16, 0, 0, 0, eastern)), Values: map[string]float64{"MACD": -0.2167, "MACD_Hist": 0.0453, "MACD_Signal": -0.262}},
+				{Time: Time(time.Date(2020, time.April, 2, 15, 45, 0, 0, eastern)), Values: map[string]float64{"MACD": -0.246, "MACD_Hist": 0.0315, "MACD_Signal": -0.2775}},
+			},
+		}, false},
+		{"Error", NewTechnicalIndicatorsService(avErr).Indicator("MACD", "symbol", TimeSeriesIntervalFifteenMinute), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IndicatorCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndicatorCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSMACall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *SMACall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).SMA("symbol", IndicatorIntervalDaily, 10, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=SMA&interval=daily&series_type=close&symbol=symbol&time_period=10", false},
+		{"15min", NewTechnicalIndicatorsService(avTest).SMA("symbol", TimeSeriesIntervalFifteenMinute, 60, SeriesTypeOpen), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=SMA&interval=15min&series_type=open&symbol=symbol&time_period=60", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SMACall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SMACall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEMACall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *EMACall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).EMA("symbol", IndicatorIntervalDaily, 10, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=EMA&interval=daily&series_type=close&symbol=symbol&time_period=10", false},
+		{"15min", NewTechnicalIndicatorsService(avTest).EMA("symbol", TimeSeriesIntervalFifteenMinute, 60, SeriesTypeOpen), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=EMA&interval=15min&series_type=open&symbol=symbol&time_period=60", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EMACall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EMACall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWMACall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *WMACall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).WMA("symbol", IndicatorIntervalDaily, 10, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=WMA&interval=daily&series_type=close&symbol=symbol&time_period=10", false},
+		{"15min", NewTechnicalIndicatorsService(avTest).WMA("symbol", TimeSeriesIntervalFifteenMinute, 60, SeriesTypeOpen), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=WMA&interval=15min&series_type=open&symbol=symbol&time_period=60", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WMACall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WMACall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDEMACall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *DEMACall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).DEMA("symbol", IndicatorIntervalDaily, 10, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=DEMA&interval=daily&series_type=close&symbol=symbol&time_period=10", false},
+		{"15min", NewTechnicalIndicatorsService(avTest).DEMA("symbol", TimeSeriesIntervalFifteenMinute, 60, SeriesTypeOpen), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=DEMA&interval=15min&series_type=open&symbol=symbol&time_period=60", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DEMACall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DEMACall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTEMACall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *TEMACall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).TEMA("symbol", IndicatorIntervalDaily, 10, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=TEMA&interval=daily&series_type=close&symbol=symbol&time_period=10", false},
+		{"15min", NewTechnicalIndicatorsService(avTest).TEMA("symbol", TimeSeriesIntervalFifteenMinute, 60, SeriesTypeOpen), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=TEMA&interval=15min&series_type=open&symbol=symbol&time_period=60", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TEMACall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TEMACall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTRIMACall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *TRIMACall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).TRIMA("symbol", IndicatorIntervalDaily, 10, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=TRIMA&interval=daily&series_type=close&symbol=symbol&time_period=10", false},
+		{"15min", NewTechnicalIndicatorsService(avTest).TRIMA("symbol", TimeSeriesIntervalFifteenMinute, 60, SeriesTypeOpen), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=TRIMA&interval=15min&series_type=open&symbol=symbol&time_period=60", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TRIMACall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TRIMACall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKAMACall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *KAMACall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).KAMA("symbol", IndicatorIntervalDaily, 10, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=KAMA&interval=daily&series_type=close&symbol=symbol&time_period=10", false},
+		{"15min", NewTechnicalIndicatorsService(avTest).KAMA("symbol", TimeSeriesIntervalFifteenMinute, 60, SeriesTypeOpen), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=KAMA&interval=15min&series_type=open&symbol=symbol&time_period=60", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("KAMACall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KAMACall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestT3Call_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *T3Call
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).T3("symbol", IndicatorIntervalDaily, 10, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=T3&interval=daily&series_type=close&symbol=symbol&time_period=10", false},
+		{"vfactor", NewTechnicalIndicatorsService(avTest).T3("symbol", IndicatorIntervalDaily, 10, SeriesTypeClose).VFactor(0.5), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=T3&interval=daily&series_type=close&symbol=symbol&time_period=10&vfactor=0.5", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("T3Call.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("T3Call.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMAMACall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *MAMACall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).MAMA("symbol", IndicatorIntervalDaily, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=MAMA&interval=daily&series_type=close&symbol=symbol", false},
+		{"limit", NewTechnicalIndicatorsService(avTest).MAMA("symbol", IndicatorIntervalDaily, SeriesTypeClose).FastLimit(0.02).SlowLimit(0.03), "https://www.alphavantage.co/query?apikey=key&datatype=csv&fastlimit=0.02&function=MAMA&interval=daily&series_type=close&slowlimit=0.03&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MAMACall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MAMACall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVWAPCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *VWAPCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"15min", NewTechnicalIndicatorsService(avTest).VWAP("symbol", TimeSeriesIntervalFifteenMinute), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=VWAP&interval=15min&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VWAPCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("VWAPCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSMACall_Do(t *testing.T) {
+	client := clientTest("key", `time,SMA
+2020-04-02,140.1234`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *SMACall
+		want    []*SMA
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewTechnicalIndicatorsService(avTest).SMA("symbol", IndicatorIntervalDaily, 10, SeriesTypeClose), []*SMA{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, eastern)), SMA: 140.1234},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SMACall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.SMA
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SMACall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMAMACall_Do(t *testing.T) {
+	client := clientTest("key", `time,FAMA,MAMA
+2020-04-02 16:00,139.4418,141.2037`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *MAMACall
+		want    []*MAMA
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewTechnicalIndicatorsService(avTest).MAMA("symbol", TimeSeriesIntervalFifteenMinute, SeriesTypeClose), []*MAMA{
+			{Time: Time(time.Date(2020, time.April, 2, 16, 0, 0, 0, eastern)), MAMA: 141.2037, FAMA: 139.4418},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MAMACall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.MAMA
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MAMACall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVWAPCall_Do(t *testing.T) {
+	client := clientTest("key", `time,VWAP
+2020-04-02 16:00,140.5512`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *VWAPCall
+		want    []*VWAP
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewTechnicalIndicatorsService(avTest).VWAP("symbol", TimeSeriesIntervalFifteenMinute), []*VWAP{
+			{Time: Time(time.Date(2020, time.April, 2, 16, 0, 0, 0, eastern)), VWAP: 140.5512},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VWAPCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.VWAP
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("VWAPCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMACDCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *MACDCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).MACD("symbol", IndicatorIntervalDaily, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=MACD&interval=daily&series_type=close&symbol=symbol", false},
+		{"period", NewTechnicalIndicatorsService(avTest).MACD("symbol", IndicatorIntervalDaily, SeriesTypeClose).FastPeriod(10).SlowPeriod(20).SignalPeriod(5), "https://www.alphavantage.co/query?apikey=key&datatype=csv&fastperiod=10&function=MACD&interval=daily&series_type=close&signalperiod=5&slowperiod=20&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MACDCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MACDCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMACDEXTCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *MACDEXTCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).MACDEXT("symbol", IndicatorIntervalDaily, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=MACDEXT&interval=daily&series_type=close&symbol=symbol", false},
+		{"matype", NewTechnicalIndicatorsService(avTest).MACDEXT("symbol", IndicatorIntervalDaily, SeriesTypeClose).FastMAType(MATypeEMA).SlowMAType(MATypeT3).SignalMAType(MATypeMAMA), "https://www.alphavantage.co/query?apikey=key&datatype=csv&fastmatype=1&function=MACDEXT&interval=daily&series_type=close&signalmatype=8&slowmatype=6&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MACDEXTCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MACDEXTCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSTOCHCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *STOCHCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).STOCH("symbol", IndicatorIntervalDaily), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=STOCH&interval=daily&symbol=symbol", false},
+		{"period", NewTechnicalIndicatorsService(avTest).STOCH("symbol", IndicatorIntervalDaily).FastKPeriod(7).SlowKPeriod(4).SlowDPeriod(2).SlowKMAType(MATypeWMA).SlowDMAType(MATypeDEMA), "https://www.alphavantage.co/query?apikey=key&datatype=csv&fastkperiod=7&function=STOCH&interval=daily&slowdmatype=3&slowdperiod=2&slowkmatype=2&slowkperiod=4&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("STOCHCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("STOCHCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSTOCHFCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *STOCHFCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).STOCHF("symbol", IndicatorIntervalDaily), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=STOCHF&interval=daily&symbol=symbol", false},
+		{"period", NewTechnicalIndicatorsService(avTest).STOCHF("symbol", IndicatorIntervalDaily).FastKPeriod(7).FastDPeriod(4).FastDMAType(MATypeTEMA), "https://www.alphavantage.co/query?apikey=key&datatype=csv&fastdmatype=4&fastdperiod=4&fastkperiod=7&function=STOCHF&interval=daily&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("STOCHFCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("STOCHFCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRSICall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *RSICall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).RSI("symbol", IndicatorIntervalDaily, 14, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=RSI&interval=daily&series_type=close&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RSICall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RSICall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMOMCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *MOMCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).MOM("symbol", IndicatorIntervalDaily, 14, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=MOM&interval=daily&series_type=close&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MOMCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MOMCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestROCCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ROCCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).ROC("symbol", IndicatorIntervalDaily, 14, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=ROC&interval=daily&series_type=close&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ROCCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ROCCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSTOCHRSICall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *STOCHRSICall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).STOCHRSI("symbol", IndicatorIntervalDaily, 14, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=STOCHRSI&interval=daily&series_type=close&symbol=symbol&time_period=14", false},
+		{"period", NewTechnicalIndicatorsService(avTest).STOCHRSI("symbol", IndicatorIntervalDaily, 14, SeriesTypeClose).FastKPeriod(7).FastDPeriod(4).FastDMAType(MATypeTRIMA), "https://www.alphavantage.co/query?apikey=key&datatype=csv&fastdmatype=5&fastdperiod=4&fastkperiod=7&function=STOCHRSI&interval=daily&series_type=close&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("STOCHRSICall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("STOCHRSICall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWILLRCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *WILLRCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).WILLR("symbol", IndicatorIntervalDaily, 14), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=WILLR&interval=daily&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WILLRCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WILLRCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestADXCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ADXCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).ADX("symbol", IndicatorIntervalDaily, 14), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=ADX&interval=daily&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ADXCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ADXCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCCICall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *CCICall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).CCI("symbol", IndicatorIntervalDaily, 14), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=CCI&interval=daily&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CCICall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CCICall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAROONCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *AROONCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).AROON("symbol", IndicatorIntervalDaily, 14), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=AROON&interval=daily&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AROONCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AROONCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMFICall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *MFICall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).MFI("symbol", IndicatorIntervalDaily, 14), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=MFI&interval=daily&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MFICall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MFICall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMACDCall_Do(t *testing.T) {
+	client := clientTest("key", `time,MACD,MACD_Hist,MACD_Signal
+2020-04-02,-0.2167,0.0453,-0.2620`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *MACDCall
+		want    []*MACD
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewTechnicalIndicatorsService(avTest).MACD("symbol", IndicatorIntervalDaily, SeriesTypeClose), []*MACD{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, eastern)), MACD: -0.2167, MACDHist: 0.0453, MACDSignal: -0.262},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MACDCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.MACD
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MACDCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSTOCHCall_Do(t *testing.T) {
+	client := clientTest("key", `time,SlowK,SlowD
+2020-04-02,45.1612,38.2041`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *STOCHCall
+		want    []*STOCH
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewTechnicalIndicatorsService(avTest).STOCH("symbol", IndicatorIntervalDaily), []*STOCH{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, eastern)), SlowK: 45.1612, SlowD: 38.2041},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("STOCHCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.STOCH
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("STOCHCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAROONCall_Do(t *testing.T) {
+	client := clientTest("key", `time,Aroon Down,Aroon Up
+2020-04-02,71.4286,14.2857`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *AROONCall
+		want    []*AROON
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewTechnicalIndicatorsService(avTest).AROON("symbol", IndicatorIntervalDaily, 14), []*AROON{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, eastern)), AroonDown: 71.4286, AroonUp: 14.2857},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AROONCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.AROON
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AROONCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBBANDSCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *BBANDSCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).BBANDS("symbol", IndicatorIntervalDaily, 20, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=BBANDS&interval=daily&series_type=close&symbol=symbol&time_period=20", false},
+		{"nbdev", NewTechnicalIndicatorsService(avTest).BBANDS("symbol", IndicatorIntervalDaily, 20, SeriesTypeClose).NBDevUp(3).NBDevDn(1).MAType(MATypeEMA), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=BBANDS&interval=daily&matype=1&nbdevdn=1&nbdevup=3&series_type=close&symbol=symbol&time_period=20", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BBANDSCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BBANDSCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestATRCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ATRCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).ATR("symbol", IndicatorIntervalDaily, 14), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=ATR&interval=daily&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ATRCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ATRCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNATRCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *NATRCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).NATR("symbol", IndicatorIntervalDaily, 14), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=NATR&interval=daily&symbol=symbol&time_period=14", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NATRCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NATRCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTRANGECall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *TRANGECall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).TRANGE("symbol", IndicatorIntervalDaily), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=TRANGE&interval=daily&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TRANGECall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TRANGECall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestADCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ADCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).AD("symbol", IndicatorIntervalDaily), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=AD&interval=daily&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ADCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ADCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOBVCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *OBVCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).OBV("symbol", IndicatorIntervalDaily), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=OBV&interval=daily&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OBVCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OBVCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestADOSCCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ADOSCCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).ADOSC("symbol", IndicatorIntervalDaily), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=ADOSC&interval=daily&symbol=symbol", false},
+		{"period", NewTechnicalIndicatorsService(avTest).ADOSC("symbol", IndicatorIntervalDaily).FastPeriod(5).SlowPeriod(12), "https://www.alphavantage.co/query?apikey=key&datatype=csv&fastperiod=5&function=ADOSC&interval=daily&slowperiod=12&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ADOSCCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ADOSCCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTrendlineCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *HTTrendlineCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).HTTrendline("symbol", IndicatorIntervalDaily, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=HT_TRENDLINE&interval=daily&series_type=close&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTTrendlineCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HTTrendlineCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTSineCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *HTSineCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).HTSine("symbol", IndicatorIntervalDaily, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=HT_SINE&interval=daily&series_type=close&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTSineCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HTSineCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTrendModeCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *HTTrendModeCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).HTTrendMode("symbol", IndicatorIntervalDaily, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=HT_TRENDMODE&interval=daily&series_type=close&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTTrendModeCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HTTrendModeCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTDCPeriodCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *HTDCPeriodCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).HTDCPeriod("symbol", IndicatorIntervalDaily, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=HT_DCPERIOD&interval=daily&series_type=close&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTDCPeriodCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HTDCPeriodCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTDCPhaseCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *HTDCPhaseCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).HTDCPhase("symbol", IndicatorIntervalDaily, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=HT_DCPHASE&interval=daily&series_type=close&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTDCPhaseCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HTDCPhaseCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTPhasorCall_doRequest(t *testing.T) {
+	client := clientTest("key", "", http.StatusOK)
+	avTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *HTPhasorCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"daily", NewTechnicalIndicatorsService(avTest).HTPhasor("symbol", IndicatorIntervalDaily, SeriesTypeClose), "https://www.alphavantage.co/query?apikey=key&datatype=csv&function=HT_PHASOR&interval=daily&series_type=close&symbol=symbol", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRsp, err := tt.c.doRequest()
+			got := gotRsp.Request.URL.String()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTPhasorCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HTPhasorCall.doRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBBANDSCall_Do(t *testing.T) {
+	client := clientTest("key", `time,Real Lower Band,Real Upper Band,Real Middle Band
+2020-04-02,130.2211,150.4411,140.3311`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *BBANDSCall
+		want    []*BBANDS
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewTechnicalIndicatorsService(avTest).BBANDS("symbol", IndicatorIntervalDaily, 20, SeriesTypeClose), []*BBANDS{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, eastern)), RealUpperBand: 150.4411, RealMiddleBand: 140.3311, RealLowerBand: 130.2211},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BBANDSCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.BBANDS
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BBANDSCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestADCall_Do(t *testing.T) {
+	client := clientTest("key", `time,Chaikin A/D
+2020-04-02,1234567.8900`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *ADCall
+		want    []*AD
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewTechnicalIndicatorsService(avTest).AD("symbol", IndicatorIntervalDaily), []*AD{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, eastern)), ChaikinAD: 1234567.89},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ADCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.AD
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ADCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTSineCall_Do(t *testing.T) {
+	client := clientTest("key", `time,LEAD SINE,SINE
+2020-04-02,0.2718,-0.4226`, http.StatusOK)
+	avTest, _ := New(client)
+	eastern, _ := time.LoadLocation("US/Eastern")
+
+	tests := []struct {
+		name    string
+		c       *HTSineCall
+		want    []*HTSine
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewTechnicalIndicatorsService(avTest).HTSine("symbol", IndicatorIntervalDaily, SeriesTypeClose), []*HTSine{
+			{Time: Time(time.Date(2020, time.April, 2, 0, 0, 0, 0, eastern)), Sine: -0.4226, LeadSine: 0.2718},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTSineCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.HTSine
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HTSineCall.Do() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,16 +8,23 @@ const (
 	userAgent = `Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:62.0) Gecko/20100101 Firefox/62.0`
 )
 
-// Transport add apikey
+// Transport add apikey from a KeyPool
 type Transport struct {
-	key               string
+	pool              *KeyPool
 	originalTransport http.RoundTripper
 }
 
-// RoundTrip add apikey
+// RoundTrip add apikey, ErrNoAPIKey if the KeyPool is nil or has no key
 func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t.pool == nil {
+		return nil, ErrNoAPIKey
+	}
+	key := t.pool.acquire()
+	if key == "" {
+		return nil, ErrNoAPIKey
+	}
 	q := r.URL.Query()
-	q.Add("apikey", t.key)
+	q.Add("apikey", key)
 	r.URL.RawQuery = q.Encode()
 
 	resp, err := t.originalTransport.RoundTrip(r)
@@ -26,12 +33,31 @@ func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 		return nil, err
 	}
 
+	// quarantine the key after a rate limit Note
+	errReply, err := peekErrorReply(resp)
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if errReply != nil && errReply.err() == ErrRateLimited {
+		t.pool.quarantine(key)
+	}
+
 	return resp, nil
 }
 
-func newTransport(key string) *Transport {
+// KeyPool returns the KeyPool of the apikeys
+func (t *Transport) KeyPool() *KeyPool {
+	return t.pool
+}
+
+func newTransport(keys ...string) *Transport {
+	return newPoolTransport(NewKeyPool(KeySelectionRoundRobin, keys...))
+}
+
+func newPoolTransport(pool *KeyPool) *Transport {
 	return &Transport{
-		key:               key,
+		pool:              pool,
 		originalTransport: http.DefaultTransport,
 	}
 }
@@ -2,8 +2,13 @@ package alphavantage
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 // ServerResponse is embedded in each Do response and
@@ -22,6 +27,9 @@ type DefaultCall struct {
 	urlParams url.Values
 	ctx       context.Context
 	header    http.Header
+
+	skipCache    bool
+	refreshCache bool
 }
 
 // Context sets the context to be used in this call's Do method. Any
@@ -40,3 +48,154 @@ func (c *DefaultCall) Header() http.Header {
 	}
 	return c.header
 }
+
+// SkipCache bypasses the Service Cache in this call's Do method,
+// the response is neither read from nor written to the cache.
+func (c *DefaultCall) SkipCache() *DefaultCall {
+	c.skipCache = true
+	return c
+}
+
+// RefreshCache sends the request in this call's Do method even if
+// the Service Cache has a response, and caches the new one.
+func (c *DefaultCall) RefreshCache() *DefaultCall {
+	c.refreshCache = true
+	return c
+}
+
+func (c *DefaultCall) doRequest() (*http.Response, error) {
+	return c.doRequestParams(c.urlParams, c.skipCache)
+}
+
+// doRequestParams sends the request with params instead of the call's urlParams,
+// bypassing the Service Cache if skipCache
+func (c *DefaultCall) doRequestParams(params url.Values, skipCache bool) (*http.Response, error) {
+	reqHeaders := make(http.Header)
+	for k, v := range c.header {
+		reqHeaders[k] = v
+	}
+	reqHeaders.Set("User-Agent", c.s.userAgent())
+
+	var body io.Reader = nil
+	urls := ResolveRelative(c.s.BasePath)
+	urls += "?" + params.Encode()
+	req, err := http.NewRequest("GET", urls, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "http.NewRequest")
+	}
+	req.Header = reqHeaders
+
+	if ttl, ok := c.s.cacheTTL(params.Get("function")); ok && !skipCache {
+		return c.s.sendCachedRequest(c.ctx, req, ttl, c.refreshCache)
+	}
+	return c.s.sendRequest(c.ctx, req)
+}
+
+// do sends the request and decodes the response by decode,
+// so every call only supplies its parameters and target type
+func (c *DefaultCall) do(decode func(res *http.Response) error) (ServerResponse, error) {
+	return c.doParams(c.urlParams, c.skipCache, decode)
+}
+
+// doParams is do with params instead of the call's urlParams, bypassing the Service Cache if skipCache,
+// so that a variant of the call leaves the call unchanged
+func (c *DefaultCall) doParams(params url.Values, skipCache bool, decode func(res *http.Response) error) (ServerResponse, error) {
+	info := newRequestInfo(params)
+	sr, err := c.send(info, params, skipCache, decode)
+	if err != nil {
+		// the transport adds apikey to the url quoted by the error
+		err = redactError(err)
+		c.s.onError(c.ctx, info, err)
+	}
+
+	return sr, err
+}
+
+// send runs the Middleware hooks around the request and decodes the response by decode
+func (c *DefaultCall) send(info *RequestInfo, params url.Values, skipCache bool, decode func(res *http.Response) error) (ServerResponse, error) {
+	if err := c.s.beforeRequest(c.ctx, info); err != nil {
+		return ServerResponse{}, err
+	}
+
+	res, err := c.doRequestParams(params, skipCache)
+	if res != nil {
+		c.s.afterResponse(c.ctx, info, res)
+	}
+	if res != nil && res.StatusCode == http.StatusNotModified {
+		if res.Body != nil {
+			res.Body.Close()
+		}
+		return ServerResponse{}, &Error{
+			Code:   res.StatusCode,
+			Header: res.Header,
+		}
+	}
+	if err != nil {
+		return ServerResponse{}, errors.Wrapf(err, "doRequest")
+	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return ServerResponse{}, errors.Wrapf(err, "CheckResponse")
+	}
+
+	if err := decode(res); err != nil {
+		return ServerResponse{}, err
+	}
+
+	return ServerResponse{
+		Header:         res.Header,
+		HTTPStatusCode: res.StatusCode,
+	}, nil
+}
+
+// doCSV sends the request and decodes the csv response into target
+func (c *DefaultCall) doCSV(target interface{}) (ServerResponse, error) {
+	return c.do(func(res *http.Response) error {
+		return errors.Wrapf(DecodeResponseCSV(target, res), "DecodeResponseCSV")
+	})
+}
+
+// doCSVIn sends the request and decodes the csv response into target,
+// whose Time columns are in loc
+func (c *DefaultCall) doCSVIn(target interface{}, loc *time.Location) (ServerResponse, error) {
+	return c.do(func(res *http.Response) error {
+		return errors.Wrapf(decodeResponseCSVIn(target, res, loc), "decodeResponseCSVIn")
+	})
+}
+
+// doJSON sends the request and decodes the json response into target
+func (c *DefaultCall) doJSON(target interface{}) (ServerResponse, error) {
+	return c.do(func(res *http.Response) error {
+		return errors.Wrapf(DecodeResponseJSON(target, res), "DecodeResponseJSON")
+	})
+}
+
+// doCSVFound sends the request and decodes the csv response into target like doCSV,
+// but returns "name could not be found" unless found after decoding,
+// so that the Middleware OnError hooks see it too
+func (c *DefaultCall) doCSVFound(target interface{}, name string, found func() bool) (ServerResponse, error) {
+	return c.do(func(res *http.Response) error {
+		if err := DecodeResponseCSV(target, res); err != nil {
+			return errors.Wrapf(err, "DecodeResponseCSV")
+		}
+		if !found() {
+			return fmt.Errorf("%s could not be found", name)
+		}
+		return nil
+	})
+}
+
+// doJSONFound sends the request and decodes the json response into target like doJSON,
+// but returns "name could not be found" unless found after decoding,
+// e.g. an unknown symbol gets an empty object, so that the Middleware OnError hooks see it too
+func (c *DefaultCall) doJSONFound(target interface{}, name string, found func() bool) (ServerResponse, error) {
+	return c.do(func(res *http.Response) error {
+		if err := DecodeResponseJSON(target, res); err != nil {
+			return errors.Wrapf(err, "DecodeResponseJSON")
+		}
+		if !found() {
+			return fmt.Errorf("%s could not be found", name)
+		}
+		return nil
+	})
+}
@@ -3,6 +3,7 @@ package alphavantage
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -51,7 +52,11 @@ func CheckResponse(res *http.Response) error {
 	errReply := &errorReply{}
 	err = json.Unmarshal(b, errReply)
 	if err != nil {
-		return nil
+		return &Error{
+			Code:   res.StatusCode,
+			Body:   string(b),
+			Header: res.Header,
+		}
 	}
 
 	return &Error{
@@ -59,7 +64,71 @@ func CheckResponse(res *http.Response) error {
 		Message: errReply.String(),
 		Body:    string(b),
 		Header:  res.Header,
+		err:     errReply.err(),
+	}
+}
+
+// readResponseBody reads the whole body of res and returns an error (of type *Error)
+// if the body is the json envelope of an Error Message or Note.
+func readResponseBody(res *http.Response) ([]byte, error) {
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "ioutil.ReadAll")
+	}
+
+	// 判斷是否有 Error Message
+	errReply := &errorReply{}
+	err = json.Unmarshal(b, errReply)
+	if err == nil && errReply.String() != "" {
+		return nil, &Error{
+			Code:    res.StatusCode,
+			Message: errReply.String(),
+			Body:    string(b),
+			Header:  res.Header,
+			err:     errReply.err(),
+		}
+	}
+
+	return b, nil
+}
+
+// newResponseCSVDecoder returns a decoder reading the csv body of res row by row,
+// so the body is never read whole. It returns an error (of type *Error)
+// if the body is the json envelope of an Error Message or Note, and a nil decoder
+// if there is no body.
+func newResponseCSVDecoder(res *http.Response) (*csvutil.Decoder, error) {
+	if res.StatusCode == http.StatusNoContent {
+		return nil, nil
 	}
+
+	errReply, err := peekErrorReply(res)
+	if err != nil {
+		return nil, err
+	}
+	if errReply != nil {
+		// the json envelope is already in memory
+		b, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			return nil, errors.Wrapf(err, "ioutil.ReadAll")
+		}
+		return nil, &Error{
+			Code:    res.StatusCode,
+			Message: errReply.String(),
+			Body:    string(b),
+			Header:  res.Header,
+			err:     errReply.err(),
+		}
+	}
+
+	dec, err := csvutil.NewDecoder(csv.NewReader(res.Body))
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "csvutil.NewDecoder")
+	}
+
+	return dec, nil
 }
 
 // DecodeResponseJSON decodes the body of res into target. If there is no body,
@@ -68,13 +137,16 @@ func DecodeResponseJSON(target interface{}, res *http.Response) error {
 	if res.StatusCode == http.StatusNoContent {
 		return nil
 	}
-	// for test
-	//b, _ := ioutil.ReadAll(res.Body)
-	//fmt.Printf("%s\n", string(b))
-	//fmt.Printf("====================================\n")
-	//return json.NewDecoder(bytes.NewBuffer(b)).Decode(target)
 
-	return json.NewDecoder(res.Body).Decode(target)
+	b, err := readResponseBody(res)
+	if err != nil {
+		return err
+	}
+
+	// fmt.Printf("%s\n", string(b))
+	// fmt.Printf("====================================\n")
+
+	return json.Unmarshal(b, target)
 }
 
 // DecodeResponseCSV decodes the body of res into target. If there is no body,
@@ -84,21 +156,9 @@ func DecodeResponseCSV(target interface{}, res *http.Response) error {
 		return nil
 	}
 
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := readResponseBody(res)
 	if err != nil {
-		return errors.Wrapf(err, "ioutil.ReadAll")
-	}
-
-	// 判斷是否有 Error Message
-	errReply := &errorReply{}
-	err = json.Unmarshal(b, errReply)
-	if err == nil && errReply.String() != "" {
-		return &Error{
-			Code:    res.StatusCode,
-			Message: errReply.String(),
-			Body:    string(b),
-			Header:  res.Header,
-		}
+		return err
 	}
 
 	// fmt.Printf("%s\n", string(b))
@@ -107,6 +167,67 @@ func DecodeResponseCSV(target interface{}, res *http.Response) error {
 	return csvutil.Unmarshal(b, target)
 }
 
+// decodeResponseCSVIn decodes the body of res into target like DecodeResponseCSV,
+// but parses every Time column in loc instead of US/Eastern.
+func decodeResponseCSVIn(target interface{}, res *http.Response, loc *time.Location) error {
+	if res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	b, err := readResponseBody(res)
+	if err != nil {
+		return err
+	}
+
+	dec, err := csvutil.NewDecoder(csv.NewReader(bytes.NewReader(b)))
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "csvutil.NewDecoder")
+	}
+	timeIn(dec, loc)
+
+	return dec.Decode(target)
+}
+
+// timeIn makes dec parse every Time column in loc,
+// by appending the time zone Time.UnmarshalCSV accepts
+func timeIn(dec *csvutil.Decoder, loc *time.Location) {
+	dec.Map = func(field, col string, v interface{}) string {
+		if _, ok := v.(Time); ok && field != "" {
+			return field + " " + loc.String()
+		}
+		return field
+	}
+}
+
+// decodeResponseCSVHeader decodes the body of res into target like decodeResponseCSVIn,
+// but renames every header column with rename before matching csv tags.
+func decodeResponseCSVHeader(target interface{}, res *http.Response, rename func(header []string) []string, loc *time.Location) error {
+	if res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	b, err := readResponseBody(res)
+	if err != nil {
+		return err
+	}
+
+	r := csv.NewReader(bytes.NewReader(b))
+	header, err := r.Read()
+	if err != nil {
+		return errors.Wrapf(err, "csv.Reader.Read")
+	}
+	dec, err := csvutil.NewDecoder(r, rename(header)...)
+	if err != nil {
+		return errors.Wrapf(err, "csvutil.NewDecoder")
+	}
+	timeIn(dec, loc)
+
+	return dec.Decode(target)
+}
+
 // SendRequest sends a single HTTP request using the given client.
 // If ctx is non-nil, it sends the request with req.WithContext
 func SendRequest(ctx context.Context, client *http.Client, req *http.Request) (*http.Response, error) {
@@ -152,16 +273,11 @@ func ResolveRelative(basePath string, elem ...string) string {
 	return u.String()
 }
 
-// parseDate parses a date value from a string.
+// parseDate parses a date value from a string in loc.
 // An error is returned if the value is not in one of the dateFormat formats.
-func parseDate(v string, dateFormat ...string) (time.Time, error) {
-	eastern, err := time.LoadLocation("US/Eastern")
-	if err != nil {
-		panic(err)
-	}
-
+func parseDate(v string, loc *time.Location, dateFormat ...string) (time.Time, error) {
 	for _, format := range dateFormat {
-		t, err := time.ParseInLocation(format, v, eastern)
+		t, err := time.ParseInLocation(format, v, loc)
 		if err != nil {
 			continue
 		}